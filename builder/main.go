@@ -1,11 +1,14 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -18,8 +21,13 @@ type Config struct {
 	TargetDirs []string        `toml:"target_dirs,omitempty"` // Multiple target directories (takes precedence)
 	SourceDir  string          `toml:"source_dir"`
 	Projects   []ProjectConfig `toml:"projects,omitempty"` // Optional: explicit project list overrides auto-discovery
+
+	InjectVersion bool   `toml:"inject_version,omitempty"` // Set VersionVar to `git describe` output via -ldflags
+	VersionVar    string `toml:"version_var,omitempty"`    // Defaults to main.version
 }
 
+const defaultVersionVar = "main.version"
+
 // GetTargetDirs returns the list of target directories, normalizing both single and multiple configs
 func (c *Config) GetTargetDirs() []string {
 	if len(c.TargetDirs) > 0 {
@@ -71,6 +79,8 @@ type model struct {
 	selected   int
 	status     string
 	quitting   bool
+	dryRun     bool   // show what a build would do without running it
+	versionVar string // variable to inject the version into, empty to disable
 }
 
 func getConfigPath() (string, error) {
@@ -142,6 +152,11 @@ target_dir = "%s"
 # Example: "%s/Code/cbraapps"
 source_dir = ""
 
+# Inject the project version into the binary at build time. The version is
+# 'git describe --tags --always', or a timestamp outside of git repos.
+# inject_version = true
+# version_var = "main.version"
+
 # Optional: Explicit project list (overrides auto-discovery if specified)
 # Leave empty to use auto-discovery from source_dir
 # [[projects]]
@@ -195,9 +210,18 @@ func initialModel() (model, error) {
 		return model{}, fmt.Errorf("either 'target_dir' or 'target_dirs' must be configured")
 	}
 
+	versionVar := ""
+	if config.InjectVersion {
+		versionVar = config.VersionVar
+		if versionVar == "" {
+			versionVar = defaultVersionVar
+		}
+	}
+
 	return model{
 		projects:   projects,
 		targetDirs: targetDirs,
+		versionVar: versionVar,
 		cursor:     0,
 		selected:   -1,
 		status:     "",
@@ -293,9 +317,15 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.selected == -1 {
 				m.selected = m.cursor
 				m.status = "Building..."
+				if m.dryRun {
+					m.status = "Planning build..."
+				}
 				return m, m.buildProject(m.cursor)
 			}
 
+		case "d":
+			m.dryRun = !m.dryRun
+
 		case "esc":
 			if m.selected != -1 {
 				m.selected = -1
@@ -320,9 +350,17 @@ func (m model) buildProject(selectedIdx int) tea.Cmd {
 
 		proj := m.projects[selectedIdx]
 
+		version := ""
+		if m.versionVar != "" {
+			version = projectVersion(proj.path)
+		}
+
+		if m.dryRun {
+			return buildResultMsg{success: true, message: dryRunPlan(proj, m.targetDirs, m.versionVar, version)}
+		}
+
 		// Build the project
-		buildCmd := exec.Command("go", "build", "-o", proj.name, ".")
-		buildCmd.Dir = proj.path
+		buildCmd := newBuildCmd(proj, m.versionVar, version)
 		buildCmd.Stdout = os.Stdout
 		buildCmd.Stderr = os.Stderr
 
@@ -334,59 +372,32 @@ func (m model) buildProject(selectedIdx int) tea.Cmd {
 		}
 
 		sourcePath := filepath.Join(proj.path, proj.name)
+		errs := installBinary(sourcePath, proj.name, m.targetDirs)
 
-		// Copy to all target directories
-		var successDirs []string
-		for i, targetDir := range m.targetDirs {
-			destPath := filepath.Join(targetDir, proj.name)
-
-			// Create target directory if it doesn't exist
-			if err := os.MkdirAll(targetDir, 0755); err != nil {
-				return buildResultMsg{
-					success: false,
-					message: fmt.Sprintf("Failed to create target directory %s: %v", targetDir, err),
-				}
-			}
-
-			// Remove old binary if it exists
-			if _, err := os.Stat(destPath); err == nil {
-				if err := os.Remove(destPath); err != nil {
-					return buildResultMsg{
-						success: false,
-						message: fmt.Sprintf("Failed to remove old binary at %s: %v", destPath, err),
-					}
-				}
+		var failures []string
+		for i, err := range errs {
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("  %s: %v", m.targetDirs[i], err))
 			}
-
-			// For the first target, move the binary; for others, copy it
-			if i == 0 {
-				if err := os.Rename(sourcePath, destPath); err != nil {
-					return buildResultMsg{
-						success: false,
-						message: fmt.Sprintf("Failed to move binary to %s: %v", targetDir, err),
-					}
-				}
-				// Update source path for subsequent copies
-				sourcePath = destPath
-			} else {
-				// Copy the file
-				if err := copyFile(sourcePath, destPath); err != nil {
-					return buildResultMsg{
-						success: false,
-						message: fmt.Sprintf("Failed to copy binary to %s: %v", targetDir, err),
-					}
-				}
+		}
+		if len(failures) > 0 {
+			return buildResultMsg{
+				success: false,
+				message: fmt.Sprintf("Built %s but failed to install to %d of %d locations:\n%s",
+					proj.name, len(failures), len(m.targetDirs), strings.Join(failures, "\n")),
 			}
-
-			successDirs = append(successDirs, targetDir)
 		}
 
 		// Build success message
+		name := proj.name
+		if version != "" {
+			name = fmt.Sprintf("%s %s", proj.name, version)
+		}
 		var message string
-		if len(successDirs) == 1 {
-			message = fmt.Sprintf("Successfully built and moved %s to %s", proj.name, successDirs[0])
+		if len(m.targetDirs) == 1 {
+			message = fmt.Sprintf("Successfully built and moved %s to %s", name, m.targetDirs[0])
 		} else {
-			message = fmt.Sprintf("Successfully built %s and copied to %d locations", proj.name, len(successDirs))
+			message = fmt.Sprintf("Successfully built %s and copied to %d locations", name, len(m.targetDirs))
 		}
 
 		return buildResultMsg{
@@ -396,6 +407,105 @@ func (m model) buildProject(selectedIdx int) tea.Cmd {
 	}
 }
 
+// newBuildCmd returns the go build command for proj, run in its directory.
+// If versionVar is set, version is injected into it via -ldflags.
+func newBuildCmd(proj project, versionVar, version string) *exec.Cmd {
+	args := []string{"build", "-o", proj.name}
+	if versionVar != "" {
+		args = append(args, "-ldflags", fmt.Sprintf("-X %s=%s", versionVar, version))
+	}
+	args = append(args, ".")
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = proj.path
+	return cmd
+}
+
+// projectVersion returns `git describe --tags --always` for the project, or
+// a build timestamp if it isn't in a git repo
+func projectVersion(projectPath string) string {
+	cmd := exec.Command("git", "describe", "--tags", "--always")
+	cmd.Dir = projectPath
+	if output, err := cmd.Output(); err == nil {
+		if version := strings.TrimSpace(string(output)); version != "" {
+			return version
+		}
+	}
+	return time.Now().Format("20060102-150405")
+}
+
+// dryRunPlan describes the build command and where the binary would go
+func dryRunPlan(proj project, targetDirs []string, versionVar, version string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Dry run for %s:\n", proj.name)
+	fmt.Fprintf(&b, "  build:   (in %s) %s\n", proj.path, strings.Join(newBuildCmd(proj, versionVar, version).Args, " "))
+	fmt.Fprintf(&b, "  source:  %s", filepath.Join(proj.path, proj.name))
+	for i, targetDir := range targetDirs {
+		action := "copy to"
+		if i == 0 {
+			action = "move to"
+		}
+		fmt.Fprintf(&b, "\n  %s: %s", action, filepath.Join(targetDir, proj.name))
+	}
+	return b.String()
+}
+
+// installBinary moves the built binary to the first target directory and
+// copies it to the others in parallel. It returns one error per target
+// directory, nil where installing succeeded.
+func installBinary(sourcePath, name string, targetDirs []string) []error {
+	errs := make([]error, len(targetDirs))
+	if len(targetDirs) == 0 {
+		return errs
+	}
+
+	// Move to the first target; the others copy from there. If the move
+	// fails, copy from the build output instead so the rest still get it.
+	firstDest := filepath.Join(targetDirs[0], name)
+	errs[0] = prepareTarget(targetDirs[0], firstDest)
+	if errs[0] == nil {
+		if err := os.Rename(sourcePath, firstDest); err != nil {
+			errs[0] = fmt.Errorf("failed to move binary: %w", err)
+		} else {
+			sourcePath = firstDest
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 1; i < len(targetDirs); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			destPath := filepath.Join(targetDirs[i], name)
+			if err := prepareTarget(targetDirs[i], destPath); err != nil {
+				errs[i] = err
+				return
+			}
+			if err := copyFile(sourcePath, destPath); err != nil {
+				errs[i] = fmt.Errorf("failed to copy binary: %w", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// prepareTarget creates the target directory and removes an old binary at destPath
+func prepareTarget(targetDir, destPath string) error {
+	if err := os.MkdirAll(targetDir, 0755); err != nil {
+		return fmt.Errorf("failed to create target directory: %w", err)
+	}
+
+	if _, err := os.Stat(destPath); err == nil {
+		if err := os.Remove(destPath); err != nil {
+			return fmt.Errorf("failed to remove old binary: %w", err)
+		}
+	}
+	return nil
+}
+
 // copyFile copies a file from src to dst
 func copyFile(src, dst string) error {
 	sourceFile, err := os.Open(src)
@@ -434,7 +544,11 @@ func (m model) View() string {
 
 	var b strings.Builder
 
-	b.WriteString(titleStyle.Render("Select project to build\n\n"))
+	title := "Select project to build"
+	if m.dryRun {
+		title += " (dry run)"
+	}
+	b.WriteString(titleStyle.Render(title + "\n\n"))
 
 	for i, proj := range m.projects {
 		cursor := " "
@@ -464,17 +578,21 @@ func (m model) View() string {
 	}
 
 	b.WriteString("\n")
-	b.WriteString("↑/↓: navigate • enter: build • q: quit\n")
+	b.WriteString("↑/↓: navigate • enter: build • d: toggle dry run • q: quit\n")
 
 	return b.String()
 }
 
 func main() {
+	dryRun := flag.Bool("dry-run", false, "Show the build command and install destinations without building")
+	flag.Parse()
+
 	m, err := initialModel()
 	if err != nil {
 		fmt.Printf("Error loading config: %v\n", err)
 		os.Exit(1)
 	}
+	m.dryRun = *dryRun
 
 	p := tea.NewProgram(m)
 	if _, err := p.Run(); err != nil {
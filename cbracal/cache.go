@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// defaultCacheTTL is how long cached events are served to one-shot modes
+// without reloading when cache_ttl is not set
+const defaultCacheTTL = 15 * time.Minute
+
+// eventCache is the on-disk snapshot of the last successful calendar load
+type eventCache struct {
+	Timestamp    time.Time                 `json:"timestamp"`
+	Events       []Event                   `json:"events"`
+	Calendars    map[string]lipgloss.Color `json:"calendars"`
+	CalendarURLs map[string]string         `json:"calendar_urls"`
+}
+
+func getCachePath() (string, error) {
+	dataDir, err := getDataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dataDir, "cache.json"), nil
+}
+
+// cacheTTL returns the configured cache lifetime
+func cacheTTL(config *Config) time.Duration {
+	if config != nil && config.CacheTTL > 0 {
+		return time.Duration(config.CacheTTL) * time.Minute
+	}
+	return defaultCacheTTL
+}
+
+// loadEventCache reads the cached events from disk
+func loadEventCache() (*eventCache, error) {
+	cachePath, err := getCachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var cache eventCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+// saveEventCache writes the loaded events to disk
+func saveEventCache(events []Event, calendars map[string]lipgloss.Color, calendarURLs map[string]string) error {
+	cachePath, err := getCachePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(eventCache{
+		Timestamp:    time.Now(),
+		Events:       events,
+		Calendars:    calendars,
+		CalendarURLs: calendarURLs,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temp file first so a crash never leaves a truncated cache
+	tmpPath := cachePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, cachePath)
+}
+
+// loadAllCalendarsCached serves events from the disk cache if it is younger
+// than ttl, otherwise loads all calendars and refreshes the cache
+func loadAllCalendarsCached(radicaleConfig *RadicaleConfig, ttl time.Duration) ([]Event, map[string]lipgloss.Color, map[string]string, error) {
+	if cache, err := loadEventCache(); err == nil && time.Since(cache.Timestamp) < ttl {
+		return cache.Events, cache.Calendars, cache.CalendarURLs, nil
+	}
+
+	events, calendars, calendarURLs, err := loadAllCalendars(radicaleConfig)
+	if err == nil {
+		if cacheErr := saveEventCache(events, calendars, calendarURLs); cacheErr != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to save event cache: %v\n", cacheErr)
+		}
+	}
+	return events, calendars, calendarURLs, err
+}
+
+// dropEventCache deletes the cached events, so the next one-shot run
+// reloads the calendars instead of serving events from before a change
+func dropEventCache() error {
+	cachePath, err := getCachePath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(cachePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
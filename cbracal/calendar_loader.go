@@ -32,14 +32,21 @@ func loadICSFromReader(reader io.Reader, calendarName string, color lipgloss.Col
 	maxDate := now.AddDate(1, 0, 0)
 
 	for _, event := range cal.Events() {
-		start, err := event.GetStartAt()
+		start, err := getEventTime(event, ics.ComponentPropertyDtStart)
 		if err != nil {
 			continue
 		}
 
-		end, err := event.GetEndAt()
+		allDay := isAllDayEvent(event)
+		declined := isDeclined(event)
+
+		end, err := getEventTime(event, ics.ComponentPropertyDtEnd)
 		if err != nil {
-			end = start.Add(time.Hour)
+			if allDay {
+				end = start.AddDate(0, 0, 1)
+			} else {
+				end = start.Add(time.Hour)
+			}
 		}
 
 		summary := ""
@@ -52,6 +59,11 @@ func loadICSFromReader(reader io.Reader, calendarName string, color lipgloss.Col
 			description = descProp.Value
 		}
 
+		location := ""
+		if locProp := event.GetProperty(ics.ComponentPropertyLocation); locProp != nil {
+			location = locProp.Value
+		}
+
 		uid := ""
 		if uidProp := event.GetProperty(ics.ComponentPropertyUniqueId); uidProp != nil {
 			uid = uidProp.Value
@@ -96,9 +108,12 @@ func loadICSFromReader(reader io.Reader, calendarName string, color lipgloss.Col
 					Start:         occ.Start,
 					End:           occ.End,
 					Description:   description,
+					Location:      location,
 					CalendarName:  calendarName,
 					CalendarColor: color,
 					UID:           uid,
+					AllDay:        allDay,
+					Declined:      declined,
 				})
 			}
 		} else {
@@ -108,9 +123,12 @@ func loadICSFromReader(reader io.Reader, calendarName string, color lipgloss.Col
 				Start:         start,
 				End:           end,
 				Description:   description,
+				Location:      location,
 				CalendarName:  calendarName,
 				CalendarColor: color,
 				UID:           uid,
+				AllDay:        allDay,
+				Declined:      declined,
 			})
 		}
 	}
@@ -118,7 +136,106 @@ func loadICSFromReader(reader io.Reader, calendarName string, color lipgloss.Col
 	return events, nil
 }
 
+// getEventTime reads a DTSTART/DTEND property and converts it to local time,
+// honoring the TZID parameter if present
+func getEventTime(event *ics.VEvent, property ics.ComponentProperty) (time.Time, error) {
+	prop := event.GetProperty(property)
+	if prop == nil {
+		return time.Time{}, fmt.Errorf("property %s not found", property)
+	}
+
+	tzid := ""
+	if values, ok := prop.ICalParameters["TZID"]; ok && len(values) > 0 {
+		tzid = values[0]
+	}
+
+	return parseICSTime(prop.Value, tzid)
+}
+
+// isAllDayEvent reports whether DTSTART is a date without a time
+// (DTSTART;VALUE=DATE:20240115)
+func isAllDayEvent(event *ics.VEvent) bool {
+	prop := event.GetProperty(ics.ComponentPropertyDtStart)
+	if prop == nil {
+		return false
+	}
+	if values, ok := prop.ICalParameters["VALUE"]; ok && len(values) > 0 && strings.EqualFold(values[0], "DATE") {
+		return true
+	}
+	return !strings.Contains(prop.Value, "T")
+}
+
+// isDeclined reports whether the ATTENDEE matching one of the configured
+// emails has PARTSTAT=DECLINED
+func isDeclined(event *ics.VEvent) bool {
+	if len(ownEmails) == 0 {
+		return false
+	}
+	for _, attendee := range event.Attendees() {
+		email := strings.ToLower(strings.TrimSpace(attendee.Value))
+		email = strings.TrimPrefix(email, "mailto:")
+		if ownEmails[email] {
+			return strings.EqualFold(string(attendee.ParticipationStatus()), string(ics.ParticipationStatusDeclined))
+		}
+	}
+	return false
+}
+
+// withoutDeclined drops events the user declined
+func withoutDeclined(events []Event) []Event {
+	kept := events[:0]
+	for _, event := range events {
+		if !event.Declined {
+			kept = append(kept, event)
+		}
+	}
+	return kept
+}
+
+// parseICSTime parses an iCalendar date-time value:
+// - "20240115T090000Z" is UTC
+// - "20240115T090000" with a TZID is in that zone
+// - "20240115T090000" without a TZID is a floating (local) time
+// The result is always converted to the local zone for display.
+func parseICSTime(value string, tzid string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.In(time.Local), nil
+	}
+
+	loc := time.Local
+	if tzid != "" {
+		// Some clients quote the TZID or prefix it with a slash
+		name := strings.TrimPrefix(strings.Trim(tzid, `"`), "/")
+		if l, err := time.LoadLocation(name); err == nil {
+			loc = l
+		}
+		// Unknown zone names (e.g. Windows zone IDs) fall back to local time
+	}
+
+	layout := "20060102T150405"
+	if !strings.Contains(value, "T") {
+		layout = "20060102"
+	}
+
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(time.Local), nil
+}
+
 func loadICSFromURL(url string, calendarName string, color lipgloss.Color) ([]Event, error) {
+	// webcal:// is just a hint for calendar apps, the feed is served over HTTP(S)
+	if rest, ok := strings.CutPrefix(url, "webcal://"); ok {
+		url = "https://" + rest
+	}
+
 	resp, err := http.Get(url)
 	if err != nil {
 		return nil, err
@@ -455,8 +572,8 @@ DESCRIPTION:%s
 END:VEVENT
 END:VCALENDAR
 `, event.UID,
-		event.Start.Format("20060102T150405Z"),
-		event.End.Format("20060102T150405Z"),
+		event.Start.UTC().Format("20060102T150405Z"),
+		event.End.UTC().Format("20060102T150405Z"),
 		escapeICSValue(event.Summary),
 		escapeICSValue(event.Description))
 
@@ -494,6 +611,17 @@ func escapeICSValue(value string) string {
 	return value
 }
 
+// calendarColor returns the configured color for a calendar, falling back to
+// the rotating default palette
+func calendarColor(config *Config, calendarName string, colorIndex int) lipgloss.Color {
+	if config != nil {
+		if color, ok := config.Colors[calendarName]; ok && color != "" {
+			return lipgloss.Color(color)
+		}
+	}
+	return calendarColors[colorIndex%len(calendarColors)]
+}
+
 func loadAllCalendars(radicaleConfig *RadicaleConfig) ([]Event, map[string]lipgloss.Color, map[string]string, error) {
 	var allEvents []Event
 	calendars := make(map[string]lipgloss.Color)
@@ -513,7 +641,7 @@ func loadAllCalendars(radicaleConfig *RadicaleConfig) ([]Event, map[string]lipgl
 			radicaleCals, err := loadCalendarsFromRadicale(radicaleConfig)
 			if err == nil {
 				for _, cal := range radicaleCals {
-					color := calendarColors[colorIndex%len(calendarColors)]
+					color := calendarColor(config, cal.DisplayName, colorIndex)
 					calendars[cal.DisplayName] = color
 					calendarURLs[cal.DisplayName] = cal.URL
 
@@ -540,7 +668,7 @@ func loadAllCalendars(radicaleConfig *RadicaleConfig) ([]Event, map[string]lipgl
 				continue
 			}
 
-			color := calendarColors[colorIndex%len(calendarColors)]
+			color := calendarColor(config, cal.Name, colorIndex)
 			calendars[cal.Name] = color
 
 			var events []Event
@@ -562,6 +690,22 @@ func loadAllCalendars(radicaleConfig *RadicaleConfig) ([]Event, map[string]lipgl
 			colorIndex++
 		}
 
+		// Load read-only subscriptions
+		for _, sub := range config.Subscriptions {
+			name := sub.DisplayName()
+			color := calendarColor(config, name, colorIndex)
+			calendars[name] = color
+
+			events, err := loadICSFromURL(sub.URL, name, color)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: Failed to load subscription %s: %v\n", name, err)
+				continue
+			}
+
+			allEvents = append(allEvents, events...)
+			colorIndex++
+		}
+
 		// Load local .ics files (only if listed in local_calendars)
 		if len(config.LocalCalendars) > 0 {
 			// Determine base directory: try current directory first (dev mode), then config directory
@@ -602,7 +746,7 @@ func loadAllCalendars(radicaleConfig *RadicaleConfig) ([]Event, map[string]lipgl
 					}
 
 					calendarName := strings.TrimSuffix(filepath.Base(icsFile), ".ics")
-					color := calendarColors[colorIndex%len(calendarColors)]
+					color := calendarColor(config, calendarName, colorIndex)
 					calendars[calendarName] = color
 
 					events, err := loadICSFromFile(icsPath, calendarName, color)
@@ -622,6 +766,10 @@ func loadAllCalendars(radicaleConfig *RadicaleConfig) ([]Event, map[string]lipgl
 		return nil, nil, nil, fmt.Errorf("no calendars found")
 	}
 
+	if config != nil && !config.ShowDeclined {
+		allEvents = withoutDeclined(allEvents)
+	}
+
 	return allEvents, calendars, calendarURLs, nil
 }
 
@@ -630,7 +778,7 @@ func getNextEvent(events []Event) *Event {
 	var upcoming []Event
 
 	for _, event := range events {
-		if event.Start.After(now) {
+		if event.Start.After(now) && !event.Declined {
 			upcoming = append(upcoming, event)
 		}
 	}
@@ -654,9 +802,12 @@ func renderNextEvent(event *Event) string {
 	var boxContent strings.Builder
 
 	timeStr := fmt.Sprintf("%s - %s",
-		event.Start.Format("Mon Jan 2, 15:04"),
-		event.End.Format("15:04"),
+		event.Start.Format("Mon Jan 2, ")+formatClock(event.Start),
+		formatClock(event.End),
 	)
+	if event.AllDay {
+		timeStr = event.Start.Format("Mon Jan 2") + ", All day"
+	}
 
 	timeUntil := time.Until(event.Start)
 	timeUntilStr := ""
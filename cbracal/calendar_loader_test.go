@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseICSTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		tzid  string
+		want  time.Time
+	}{
+		{"utc", "20240115T090000Z", "", time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)},
+		{"tzid", "20240115T090000", "America/New_York", time.Date(2024, 1, 15, 9, 0, 0, 0, newYork)},
+		{"quoted tzid", "20240115T090000", `"/America/New_York"`, time.Date(2024, 1, 15, 9, 0, 0, 0, newYork)},
+		{"floating", "20240115T090000", "", time.Date(2024, 1, 15, 9, 0, 0, 0, time.Local)},
+		{"date only", "20240115", "", time.Date(2024, 1, 15, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseICSTime(tt.value, tt.tzid)
+			if err != nil {
+				t.Fatalf("parseICSTime(%q, %q) error: %v", tt.value, tt.tzid, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseICSTime(%q, %q) = %v, want %v", tt.value, tt.tzid, got, tt.want)
+			}
+			if got.Location() != time.Local {
+				t.Errorf("parseICSTime(%q, %q) location = %v, want local", tt.value, tt.tzid, got.Location())
+			}
+		})
+	}
+}
+
+func TestParseICSTimeInvalid(t *testing.T) {
+	for _, value := range []string{"", "2024-01-15", "20240115T0900Z"} {
+		if _, err := parseICSTime(value, ""); err == nil {
+			t.Errorf("parseICSTime(%q) succeeded, want error", value)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
@@ -32,17 +34,144 @@ const defaultConfigTOML = `# cbracal - Calendar Configuration
 # file = "personal.ics"
 # type = "file"
 
+# Read-only calendar feeds, e.g. shared or public calendars without CalDAV
+# [[subscriptions]]
+# name = "Holidays"
+# url = "webcal://example.com/holidays.ics"
+
 # Local .ics files in the config directory
 # local_calendars = ["work.ics", "personal.ics"]
 
+# Display settings
+# week_start = "monday"   # "monday" or "sunday"
+# time_format = "24h"     # "24h" or "12h"
+
+# Minutes that cached events are served to one-shot modes (--next, --list, ...)
+# cache_ttl = 15
+
+# Your email addresses, used to find invitations you declined. Declined events
+# are hidden unless show_declined is set, then they are struck through.
+# emails = ["me@example.com"]
+# show_declined = false
+
+# Days ahead that --search and "/" look for matching events, and whether to
+# match the location and description as well as the title
+# search_days = 365
+# search_details = false
+
+# Color events from cool (short) to warm (long) instead of by calendar, also
+# toggled with "c". Calendars listed under [colors] keep their color.
+# duration_colors = false
+
+# Per-calendar colors (calendar name -> hex color or ANSI color number)
+# Calendars without a color get one from the default palette
+# [colors]
+# "Work" = "#FF7CCB"
+# "Personal" = "117"
+
 # Notification daemon settings (for cbracal --daemon mode)
 [notifications]
 enabled = true
 check_interval = 60          # seconds between checking for upcoming events
-advance_notice = [15, 5, 1]  # minutes before event to send notifications
+advance_notice = [15, 5, 1]  # minutes (or durations like "1h") before event to send notifications
 reload_interval = 5          # minutes between full calendar reloads
 `
 
+// Display settings, set from the config by applyDisplayConfig
+var (
+	weekStartDay     = time.Monday
+	clockLayout      = "15:04"
+	configuredColors = make(map[string]bool) // calendars with a color under [colors]
+)
+
+// applyDisplayConfig sets the week start and time format used by all views
+func applyDisplayConfig(config *Config) {
+	if config == nil {
+		return
+	}
+
+	switch strings.ToLower(config.WeekStart) {
+	case "sunday":
+		weekStartDay = time.Sunday
+	default:
+		weekStartDay = time.Monday
+	}
+
+	switch strings.ToLower(config.TimeFormat) {
+	case "12h":
+		clockLayout = "3:04 PM"
+	default:
+		clockLayout = "15:04"
+	}
+
+	for name, color := range config.Colors {
+		if color != "" {
+			configuredColors[name] = true
+		}
+	}
+}
+
+// ownEmails are the user's lowercased email addresses from the config, used
+// to find their ATTENDEE line in invitations
+var ownEmails = make(map[string]bool)
+
+// applyAttendeeConfig sets the email addresses events are checked against,
+// replacing any set before
+func applyAttendeeConfig(config *Config) {
+	ownEmails = make(map[string]bool)
+	if config == nil {
+		return
+	}
+	for _, email := range config.Emails {
+		ownEmails[strings.ToLower(strings.TrimSpace(email))] = true
+	}
+}
+
+// defaultSearchDays is how far ahead search looks without search_days
+const defaultSearchDays = 365
+
+// Search settings, set from the config by applySearchConfig
+var (
+	searchDays    = defaultSearchDays
+	searchDetails = false
+)
+
+// applySearchConfig sets the search window and which fields are matched
+func applySearchConfig(config *Config) {
+	if config == nil {
+		return
+	}
+	if config.SearchDays > 0 {
+		searchDays = config.SearchDays
+	}
+	searchDetails = config.SearchDetails
+}
+
+// formatClock formats a time of day according to the configured time format
+func formatClock(t time.Time) string {
+	return t.Format(clockLayout)
+}
+
+// timeRangeWidth is the widest "start - end" string in the configured time
+// format, used to align event summaries
+func timeRangeWidth() int {
+	widest := time.Date(2000, 1, 1, 12, 0, 0, 0, time.Local)
+	return 2*len(formatClock(widest)) + len(" - ")
+}
+
+// readOnlyCalendars returns the names of subscribed calendars, which are
+// skipped when creating events
+func readOnlyCalendars(config *Config) map[string]bool {
+	readOnly := make(map[string]bool)
+	if config == nil {
+		return readOnly
+	}
+	for _, sub := range config.Subscriptions {
+		readOnly[sub.DisplayName()] = true
+	}
+	return readOnly
+}
+
 func getConfigDir() (string, error) {
 	usr, err := user.Current()
 	if err != nil {
@@ -127,7 +127,7 @@ func (m model) saveEventFromForm() (tea.Model, tea.Cmd) {
 	if err != nil {
 		m.message = fmt.Sprintf("Invalid date: %v (use DD-MM-YYYY)", err)
 		m.creationMode = NoCreation
-		m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+		m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 		return m, m.eventForm.Init()
 	}
 
@@ -139,7 +139,7 @@ func (m model) saveEventFromForm() (tea.Model, tea.Cmd) {
 		if err1 != nil || err2 != nil {
 			m.message = "Invalid time format (use HH:MM)"
 			m.creationMode = NoCreation
-			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 			return m, m.eventForm.Init()
 		}
 
@@ -158,7 +158,7 @@ func (m model) saveEventFromForm() (tea.Model, tea.Cmd) {
 		if end.Before(start) || end.Equal(start) {
 			m.message = "End time must be after start time"
 			m.creationMode = NoCreation
-			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 			return m, m.eventForm.Init()
 		}
 	}
@@ -176,7 +176,7 @@ func (m model) saveEventFromForm() (tea.Model, tea.Cmd) {
 		if err != nil {
 			m.message = fmt.Sprintf("Invalid repeat end date: %v (use DD-MM-YYYY)", err)
 			m.creationMode = NoCreation
-			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 			return m, m.eventForm.Init()
 		}
 	}
@@ -254,8 +254,11 @@ func (m model) saveEventFromForm() (tea.Model, tea.Cmd) {
 		if m.radicaleConfig != nil && m.calendarURLs[*m.formCalendar] != "" {
 			if err := createEventOnRadicale(m.calendarURLs[*m.formCalendar], event, m.radicaleConfig); err != nil {
 				m.message = fmt.Sprintf("Error creating event: %v", err)
+				if savedCount > 0 {
+					m.invalidateCache()
+				}
 				m.creationMode = NoCreation
-				m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+				m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 				return m, m.eventForm.Init()
 			}
 		}
@@ -269,11 +272,12 @@ func (m model) saveEventFromForm() (tea.Model, tea.Cmd) {
 		} else {
 			m.message = fmt.Sprintf("%d events created successfully!", savedCount)
 		}
+		m.invalidateCache()
 	}
 
 	m.creationMode = NoCreation
 	// Rebuild form for next time
-	m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+	m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 	return m, m.eventForm.Init()
 }
 
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
 	"sort"
 	"strings"
+	"syscall"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -21,11 +25,18 @@ func main() {
 	monthFlag := flag.Bool("month", false, "Show monthly view and quit")
 	listFlag := flag.String("list", "", "List events for a specific day (format: YYYY-MM-DD, 'today', 'tomorrow', or empty for today)")
 	listTodayFlag := flag.Bool("today", false, "List today's events (shortcut for --list today)")
-	jsonFlag := flag.Bool("json", false, "Output in JSON format (use with --list or --today)")
+	agendaFlag := flag.Int("agenda", 0, "Show agenda for the next N days and quit")
+	skipEmptyFlag := flag.Bool("skip-empty", false, "Hide days without events (use with --agenda)")
+	conflictsFlag := flag.Bool("conflicts", false, "List overlapping events for a day (use with --list for a specific day)")
+	jsonFlag := flag.Bool("json", false, "Output in JSON format (use with --list, --today, --conflicts, --agenda, or --search)")
 	daemonFlag := flag.Bool("daemon", false, "Run notification daemon in the background")
+	searchFlag := flag.String("search", "", "List upcoming events whose title matches the text (use --json for JSON)")
 	flag.Parse()
 
 	config, _ := loadConfig()
+	applyDisplayConfig(config)
+	applyAttendeeConfig(config)
+	applySearchConfig(config)
 	var radicaleConfig *RadicaleConfig
 	if config != nil && config.Radicale != nil {
 		radicaleConfig = config.Radicale
@@ -45,37 +56,51 @@ func main() {
 		return
 	}
 
-	// Handle --list and --today flags
-	if *listTodayFlag || flag.Lookup("list").Value.String() != "" || *listFlag != "" {
-		events, _, _, err := loadAllCalendars(radicaleConfig)
+	// Handle --search flag
+	if *searchFlag != "" {
+		events, _, _, err := loadAllCalendarsCached(radicaleConfig, cacheTTL(config))
+		if err != nil {
+			fmt.Printf("Error loading calendars: %v\n", err)
+			return
+		}
+
+		hits := searchEvents(events, *searchFlag, time.Now())
+		if *jsonFlag {
+			fmt.Println(formatEventsJSON(hits))
+		} else if len(hits) == 0 {
+			fmt.Printf("No events matching %q in the next %d days\n", *searchFlag, searchDays)
+		} else {
+			fmt.Print(formatSearchResults(hits))
+		}
+		return
+	}
+
+	// Handle --list, --today, and --conflicts flags
+	if *listTodayFlag || *conflictsFlag || flag.Lookup("list").Value.String() != "" || *listFlag != "" {
+		events, _, _, err := loadAllCalendarsCached(radicaleConfig, cacheTTL(config))
 		if err != nil {
 			fmt.Printf("Error loading calendars: %v\n", err)
 			return
 		}
 
 		// Determine target date
-		targetDate := time.Now()
 		dateStr := *listFlag
 		if *listTodayFlag {
 			dateStr = "today"
 		}
-
-		if dateStr != "" && dateStr != "today" {
-			if dateStr == "tomorrow" {
-				targetDate = time.Now().AddDate(0, 0, 1)
-			} else {
-				parsed, err := time.Parse("2006-01-02", dateStr)
-				if err != nil {
-					fmt.Printf("Invalid date format: %s (use YYYY-MM-DD, 'today', or 'tomorrow')\n", dateStr)
-					return
-				}
-				targetDate = parsed
-			}
+		targetDate, err := parseDateArg(dateStr)
+		if err != nil {
+			fmt.Printf("Invalid date format: %s (use YYYY-MM-DD, 'today', or 'tomorrow')\n", dateStr)
+			return
 		}
 
 		// Filter and output events
 		dayEvents := getEventsForDay(events, targetDate)
-		if *jsonFlag {
+		if *conflictsFlag && *jsonFlag {
+			fmt.Println(formatConflictsJSON(findConflicts(dayEvents)))
+		} else if *conflictsFlag {
+			fmt.Print(formatConflictsList(findConflicts(dayEvents)))
+		} else if *jsonFlag {
 			fmt.Println(formatEventsJSON(dayEvents))
 		} else {
 			fmt.Print(formatEventsList(dayEvents, targetDate))
@@ -84,8 +109,8 @@ func main() {
 	}
 
 	// For one-shot modes, we need to load calendars synchronously
-	if *nextFlag || *dayFlag || *weekFlag || *monthFlag {
-		events, calendars, calendarURLs, _ := loadAllCalendars(radicaleConfig)
+	if *nextFlag || *dayFlag || *weekFlag || *monthFlag || *agendaFlag > 0 {
+		events, calendars, calendarURLs, _ := loadAllCalendarsCached(radicaleConfig, cacheTTL(config))
 
 		if *nextFlag {
 			nextEvent := getNextEvent(events)
@@ -93,30 +118,56 @@ func main() {
 			return
 		}
 
+		if *agendaFlag > 0 && *jsonFlag {
+			fmt.Println(formatEventsJSON(getEventsForRange(events, time.Now(), *agendaFlag)))
+			return
+		}
+
 		viewMode := DailyView
 		if *weekFlag {
 			viewMode = WeeklyView
 		} else if *monthFlag {
 			viewMode = MonthlyView
+		} else if *agendaFlag > 0 {
+			viewMode = AgendaView
 		}
 
 		m := initialModel(viewMode, true, radicaleConfig)
+		if *agendaFlag > 0 {
+			m.agendaDays = *agendaFlag
+			m.agendaSkipEmpty = *skipEmptyFlag
+		}
 		m.events = events
 		m.calendars = calendars
 		m.calendarURLs = calendarURLs
-		m.isLoading = false
-		// Set default selected calendar
-		for name := range m.calendars {
-			m.selectedCalendar = name
-			break
+		m.readOnly = readOnlyCalendars(config)
+		if config != nil {
+			m.durationColors = config.DurationColors
 		}
+		m.isLoading = false
+		m.selectDefaultCalendar()
 
 		fmt.Println(m.View())
 		return
 	}
 
-	// Interactive mode - load calendars async with spinner
+	// Interactive mode - show cached events instantly if available and
+	// load calendars async, with a spinner if there is nothing cached
 	m := initialModel(DailyView, false, radicaleConfig)
+	m.readOnly = readOnlyCalendars(config)
+	if config != nil {
+		m.durationColors = config.DurationColors
+	}
+	if cache, err := loadEventCache(); err == nil {
+		m.events = cache.Events
+		m.calendars = cache.Calendars
+		m.calendarURLs = cache.CalendarURLs
+		m.cacheTime = cache.Timestamp
+		m.isLoading = false
+		m.isRefreshing = true
+		m.selectDefaultCalendar()
+		m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
+	}
 
 	p := tea.NewProgram(m)
 	if _, err := p.Run(); err != nil {
@@ -124,6 +175,19 @@ func main() {
 	}
 }
 
+// parseDateArg parses a date given on the command line: YYYY-MM-DD, "today",
+// "tomorrow", or empty for today
+func parseDateArg(dateStr string) (time.Time, error) {
+	now := time.Now()
+	switch strings.ToLower(strings.TrimSpace(dateStr)) {
+	case "", "today":
+		return now, nil
+	case "tomorrow":
+		return now.AddDate(0, 0, 1), nil
+	}
+	return time.ParseInLocation("2006-01-02", strings.TrimSpace(dateStr), time.Local)
+}
+
 // getEventsForDay returns all events that occur on the specified day
 func getEventsForDay(events []Event, day time.Time) []Event {
 	var dayEvents []Event
@@ -150,6 +214,118 @@ func getEventsForDay(events []Event, day time.Time) []Event {
 	return dayEvents
 }
 
+// getEventsForRange returns all events occurring within the given number of
+// days starting at start, each event listed once
+func getEventsForRange(events []Event, start time.Time, days int) []Event {
+	var rangeEvents []Event
+	seen := make(map[string]bool)
+
+	for i := 0; i < days; i++ {
+		for _, event := range getEventsForDay(events, start.AddDate(0, 0, i)) {
+			key := event.UID + event.Summary + event.Start.String()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			rangeEvents = append(rangeEvents, event)
+		}
+	}
+
+	return rangeEvents
+}
+
+// searchEvents returns the events from the start of from's day through the
+// search window whose title contains query, ignoring case. With
+// search_details the location and description are matched too.
+func searchEvents(events []Event, query string, from time.Time) []Event {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+
+	var hits []Event
+	for _, event := range getEventsForRange(events, from, searchDays) {
+		text := event.Summary
+		if searchDetails {
+			text += "\n" + event.Location + "\n" + event.Description
+		}
+		if strings.Contains(strings.ToLower(text), query) {
+			hits = append(hits, event)
+		}
+	}
+	return hits
+}
+
+// formatSearchResults formats search hits as "date time summary" lines
+func formatSearchResults(events []Event) string {
+	var sb strings.Builder
+	for _, event := range events {
+		when := "All day"
+		if !event.AllDay {
+			when = formatClock(event.Start) + "-" + formatClock(event.End)
+		}
+		location := ""
+		if event.Location != "" {
+			location = " @ " + event.Location
+		}
+		sb.WriteString(fmt.Sprintf("%s %s %s%s\n", event.Start.Format("Mon 2006-01-02"), when, event.Summary, location))
+	}
+	return sb.String()
+}
+
+// conflictPairs returns the index pairs of timed events whose [Start,End)
+// intervals overlap. All-day and declined events never conflict.
+func conflictPairs(events []Event) [][2]int {
+	var pairs [][2]int
+	for i := 0; i < len(events); i++ {
+		for j := i + 1; j < len(events); j++ {
+			if eventsOverlap(events[i], events[j]) {
+				pairs = append(pairs, [2]int{i, j})
+			}
+		}
+	}
+	return pairs
+}
+
+// findConflicts returns all pairs of overlapping events
+func findConflicts(events []Event) [][2]Event {
+	var conflicts [][2]Event
+	for _, pair := range conflictPairs(events) {
+		conflicts = append(conflicts, [2]Event{events[pair[0]], events[pair[1]]})
+	}
+	return conflicts
+}
+
+// conflictingEvents returns the indices of events that overlap with at least
+// one other event in the slice
+func conflictingEvents(events []Event) map[int]bool {
+	conflicting := make(map[int]bool)
+	for _, pair := range conflictPairs(events) {
+		conflicting[pair[0]] = true
+		conflicting[pair[1]] = true
+	}
+	return conflicting
+}
+
+func eventsOverlap(a, b Event) bool {
+	if a.AllDay || b.AllDay || a.Declined || b.Declined {
+		return false
+	}
+	return a.Start.Before(b.End) && b.Start.Before(a.End)
+}
+
+// formatConflictsList formats conflicting pairs as plain text for shell scripts
+func formatConflictsList(conflicts [][2]Event) string {
+	var sb strings.Builder
+	for _, pair := range conflicts {
+		sb.WriteString(fmt.Sprintf("%s-%s %s <> %s-%s %s\n",
+			formatClock(pair[0].Start), formatClock(pair[0].End), pair[0].Summary,
+			formatClock(pair[1].Start), formatClock(pair[1].End), pair[1].Summary,
+		))
+	}
+	return sb.String()
+}
+
 // formatEventsList formats events as plain text for shell scripts
 func formatEventsList(events []Event, day time.Time) string {
 	if len(events) == 0 {
@@ -158,45 +334,85 @@ func formatEventsList(events []Event, day time.Time) string {
 
 	var sb strings.Builder
 	for _, event := range events {
-		startTime := event.Start.Format("15:04")
-		endTime := event.End.Format("15:04")
+		location := ""
+		if event.Location != "" {
+			location = " @ " + event.Location
+		}
+		if event.Declined {
+			location += " (declined)"
+		}
+
+		if event.AllDay {
+			sb.WriteString(fmt.Sprintf("All day %s%s\n", event.Summary, location))
+			continue
+		}
+
+		startTime := formatClock(event.Start)
+		endTime := formatClock(event.End)
 		duration := formatDuration(event.End.Sub(event.Start))
 
-		sb.WriteString(fmt.Sprintf("%s-%s (%s) %s\n", startTime, endTime, duration, event.Summary))
+		sb.WriteString(fmt.Sprintf("%s-%s (%s) %s%s\n", startTime, endTime, duration, event.Summary, location))
 	}
 
 	return sb.String()
 }
 
+// eventJSON is the JSON representation of an event for --json output
+type eventJSON struct {
+	Title    string `json:"title"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Duration string `json:"duration"`
+	AllDay   bool   `json:"all_day"`
+	Calendar string `json:"calendar"`
+	UID      string `json:"uid"`
+}
+
+// newEventJSON converts event for the JSON output
+func newEventJSON(event Event) eventJSON {
+	duration := formatDuration(event.End.Sub(event.Start))
+	if event.AllDay {
+		duration = "all day"
+	}
+
+	return eventJSON{
+		Title:    event.Summary,
+		Start:    event.Start.Format(time.RFC3339),
+		End:      event.End.Format(time.RFC3339),
+		Duration: duration,
+		AllDay:   event.AllDay,
+		Calendar: event.CalendarName,
+		UID:      event.UID,
+	}
+}
+
 // formatEventsJSON formats events as JSON for programmatic use
 func formatEventsJSON(events []Event) string {
-	if len(events) == 0 {
+	out := make([]eventJSON, 0, len(events))
+	for _, event := range events {
+		out = append(out, newEventJSON(event))
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
 		return "[]"
 	}
+	return string(data)
+}
 
-	var sb strings.Builder
-	sb.WriteString("[\n")
-	for i, event := range events {
-		duration := formatDuration(event.End.Sub(event.Start))
-		// Escape JSON strings
-		title := strings.ReplaceAll(event.Summary, `"`, `\"`)
-		title = strings.ReplaceAll(title, "\n", "\\n")
-
-		sb.WriteString(fmt.Sprintf(`  {"title":"%s","start":"%s","end":"%s","duration":"%s","calendar":"%s"}`,
-			title,
-			event.Start.Format("15:04"),
-			event.End.Format("15:04"),
-			duration,
-			event.CalendarName,
-		))
-		if i < len(events)-1 {
-			sb.WriteString(",")
-		}
-		sb.WriteString("\n")
+// formatConflictsJSON formats conflicting pairs as JSON, one two-event
+// array per pair
+func formatConflictsJSON(conflicts [][2]Event) string {
+	out := make([][2]eventJSON, 0, len(conflicts))
+	for _, pair := range conflicts {
+		out = append(out, [2]eventJSON{newEventJSON(pair[0]), newEventJSON(pair[1])})
 	}
-	sb.WriteString("]")
 
-	return sb.String()
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
 }
 
 // formatDuration formats a duration in a human-readable way
@@ -213,6 +429,17 @@ func formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%dh%dm", hours, minutes)
 }
 
+// newNotifyConfig converts the notifications section of the config for the
+// daemon. Each call returns a new value, the daemon's copy is never changed.
+func newNotifyConfig(notifConfig *NotificationConfig) *notify.NotificationConfig {
+	return &notify.NotificationConfig{
+		Enabled:        notifConfig.Enabled,
+		CheckInterval:  notifConfig.CheckInterval,
+		AdvanceNotice:  notifConfig.AdvanceNotice.Minutes(),
+		ReloadInterval: notifConfig.ReloadInterval,
+	}
+}
+
 // runDaemon starts the notification daemon
 func runDaemon(notifConfig *NotificationConfig, radicaleConfig *RadicaleConfig) {
 	// Create event loader function that wraps loadAllCalendars
@@ -222,31 +449,54 @@ func runDaemon(notifConfig *NotificationConfig, radicaleConfig *RadicaleConfig)
 			return nil, err
 		}
 
-		// Convert main.Event to notify.Event
-		notifyEvents := make([]notify.Event, len(events))
-		for i, e := range events {
-			notifyEvents[i] = notify.Event{
+		// Convert main.Event to notify.Event, skipping declined events
+		// which are kept when show_declined is set
+		notifyEvents := make([]notify.Event, 0, len(events))
+		for _, e := range events {
+			if e.Declined {
+				continue
+			}
+			notifyEvents = append(notifyEvents, notify.Event{
 				Summary:      e.Summary,
 				Start:        e.Start,
 				End:          e.End,
 				Description:  e.Description,
 				CalendarName: e.CalendarName,
 				UID:          e.UID,
-			}
+			})
 		}
 		return notifyEvents, nil
 	}
 
 	// Create notify config
-	config := &notify.NotificationConfig{
-		Enabled:        notifConfig.Enabled,
-		CheckInterval:  notifConfig.CheckInterval,
-		AdvanceNotice:  notifConfig.AdvanceNotice,
-		ReloadInterval: notifConfig.ReloadInterval,
-	}
-
-	// Create and run daemon
+	config := newNotifyConfig(notifConfig)
 	daemon := notify.NewDaemon(config, loader)
+
+	// Reload the config on SIGHUP. Calendars are read from the config on
+	// every load; the notification settings are handed to the running
+	// daemon, which keeps the reminders it already sent.
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			newConfig, err := loadConfig()
+			if err != nil {
+				log.Printf("Config reload failed: %v", err)
+				continue
+			}
+			if newConfig.Notifications == nil {
+				log.Printf("Config reload skipped: no notification configuration found")
+				continue
+			}
+
+			config := newNotifyConfig(newConfig.Notifications)
+			daemon.SetConfig(config)
+			log.Printf("Config reloaded (check interval %ds, advance notice %v min, reload interval %dm)",
+				config.CheckInterval, config.AdvanceNotice, config.ReloadInterval)
+		}
+	}()
+
+	// Run daemon
 	if err := daemon.Run(); err != nil {
 		log.Fatalf("Daemon error: %v", err)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
@@ -46,6 +47,7 @@ func initialModel(viewMode ViewMode, oneShot bool, radicaleConfig *RadicaleConfi
 		events:           []Event{},
 		calendars:        calendars,
 		calendarURLs:     calendarURLs,
+		readOnly:         make(map[string]bool),
 		currentDate:      currentDate,
 		viewMode:         viewMode,
 		oneShot:          oneShot,
@@ -71,6 +73,56 @@ func initialModel(viewMode ViewMode, oneShot bool, radicaleConfig *RadicaleConfi
 		formRepeatOptions: &repeatOptions,
 		formRepeatEndDate: &repeatEndDate,
 		formScrollOffset:  0,
+		agendaDays:        defaultAgendaDays,
+		agendaViewport:    viewport.New(80, 20),
+	}
+}
+
+// defaultAgendaDays is the agenda length when not set via --agenda
+const defaultAgendaDays = 14
+
+// agendaChromeHeight is the number of lines the agenda view needs for
+// title, date header, legend, and help bar around the viewport
+const agendaChromeHeight = 10
+
+// refreshAgenda re-renders the agenda content into the viewport. It must be
+// called from Update whenever events, the date, or the window size change.
+func (m *model) refreshAgenda() {
+	if m.width > 0 {
+		m.agendaViewport.Width = m.width
+	}
+	if m.height > agendaChromeHeight {
+		m.agendaViewport.Height = m.height - agendaChromeHeight
+	}
+	m.agendaViewport.SetContent(m.renderAgendaContent())
+}
+
+// writableCalendars returns the calendars events can be created in, i.e.
+// all except subscriptions
+func (m model) writableCalendars() map[string]lipgloss.Color {
+	writable := make(map[string]lipgloss.Color, len(m.calendars))
+	for name, color := range m.calendars {
+		if !m.readOnly[name] {
+			writable[name] = color
+		}
+	}
+	return writable
+}
+
+// invalidateCache deletes the disk cache after events were created, so
+// one-shot modes don't keep serving the events from before
+func (m *model) invalidateCache() {
+	if err := dropEventCache(); err != nil {
+		m.message += fmt.Sprintf(" (clearing the event cache failed: %v)", err)
+	}
+}
+
+// selectDefaultCalendar picks the calendar new events go to by default
+func (m *model) selectDefaultCalendar() {
+	for name := range m.writableCalendars() {
+		m.selectedCalendar = name
+		*m.formCalendar = name
+		return
 	}
 }
 
@@ -78,11 +130,16 @@ func initialModel(viewMode ViewMode, oneShot bool, radicaleConfig *RadicaleConfi
 func loadCalendarsCmd(radicaleConfig *RadicaleConfig) tea.Cmd {
 	return func() tea.Msg {
 		events, calendars, calendarURLs, err := loadAllCalendars(radicaleConfig)
+		var cacheErr error
+		if err == nil {
+			cacheErr = saveEventCache(events, calendars, calendarURLs)
+		}
 		return calendarsLoadedMsg{
 			events:       events,
 			calendars:    calendars,
 			calendarURLs: calendarURLs,
 			err:          err,
+			cacheErr:     cacheErr,
 		}
 	}
 }
@@ -90,15 +147,30 @@ func (m model) Init() tea.Cmd {
 	if m.oneShot {
 		return tea.Quit
 	}
-	// Start spinner and load calendars asynchronously
+	// Start spinner and load calendars asynchronously. If cached events are
+	// already shown, this refreshes them in the background.
 	return tea.Batch(
 		tea.SetWindowTitle("cbracal"),
 		m.loadingSpinner.Tick,
 		loadCalendarsCmd(m.radicaleConfig),
+		countdownTick(),
 	)
 }
 
+// countdownTick fires on every wall clock minute so the countdown changes
+// together with the clock
+func countdownTick() tea.Cmd {
+	return tea.Every(time.Minute, func(time.Time) tea.Msg {
+		return countdownTickMsg{}
+	})
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Keep the countdown ticking in every mode, the form would swallow it
+	if _, ok := msg.(countdownTickMsg); ok {
+		return m, countdownTick()
+	}
+
 	// If we're in form mode, handle ALL messages through the form first
 	// This gives the form complete control over its own state
 	if m.creationMode == UIFormInput && m.eventForm != nil {
@@ -133,7 +205,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.formScrollOffset = 0
 			m.message = ""
 			// Rebuild form for next time
-			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 			return m, m.eventForm.Init()
 		}
 
@@ -147,10 +219,11 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 		m.loadingProgress.Width = m.width - 10
+		m.refreshAgenda()
 		return m, nil
 
 	case spinner.TickMsg:
-		if m.isLoading {
+		if m.isLoading || m.isRefreshing {
 			var cmd tea.Cmd
 			m.loadingSpinner, cmd = m.loadingSpinner.Update(msg)
 			return m, cmd
@@ -160,7 +233,13 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case calendarsLoadedMsg:
 		m.isLoading = false
 		m.loadingMessage = ""
-		if msg.err != nil {
+		wasRefreshing := m.isRefreshing
+		m.isRefreshing = false
+		if msg.err != nil && wasRefreshing {
+			// Keep showing the cached events
+			m.message = fmt.Sprintf("Refresh failed: %v", msg.err)
+			return m, nil
+		} else if msg.err != nil {
 			// Set fallback sample data on error
 			currentDate := time.Now()
 			m.events = []Event{
@@ -189,15 +268,19 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.events = msg.events
 			m.calendars = msg.calendars
 			m.calendarURLs = msg.calendarURLs
+			if m.searchActive {
+				m.updateSearch()
+			}
+			m.cacheTime = time.Now()
+			m.message = ""
+			if msg.cacheErr != nil {
+				m.message = fmt.Sprintf("Saving the event cache failed: %v", msg.cacheErr)
+			}
 		}
-		// Set default selected calendar
-		for name := range m.calendars {
-			m.selectedCalendar = name
-			*m.formCalendar = name
-			break
-		}
+		m.selectDefaultCalendar()
 		// Rebuild the event form with the loaded calendars
-		m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+		m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
+		m.refreshAgenda()
 		return m, nil
 
 	case progress.FrameMsg:
@@ -227,12 +310,44 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if msg.String() == "l" {
 				m.creationMode = UIFormInput
 				// Rebuild form
-				m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+				m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 				return m, m.eventForm.Init()
 			}
 			return m.handleEventCreationInput(msg)
 		}
 
+		if m.gotoActive {
+			return m.handleGotoInput(msg)
+		}
+
+		if m.detailEvent != nil {
+			switch msg.String() {
+			case "ctrl+c":
+				return m, tea.Quit
+			case "esc", "enter", "q", "backspace":
+				m.detailEvent = nil
+			}
+			return m, nil
+		}
+
+		if m.searchActive {
+			return m.handleSearchInput(msg)
+		}
+
+		// Agenda view scrolling
+		if m.viewMode == AgendaView {
+			switch msg.String() {
+			case "up", "k", "down", "j", "pgup", "pgdown", "ctrl+u", "ctrl+d":
+				var cmd tea.Cmd
+				m.agendaViewport, cmd = m.agendaViewport.Update(msg)
+				return m, cmd
+			case "e":
+				m.agendaSkipEmpty = !m.agendaSkipEmpty
+				m.refreshAgenda()
+				return m, nil
+			}
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c":
 			return m, tea.Quit
@@ -249,29 +364,70 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			*m.formRepeatEndDate = ""
 			m.formScrollOffset = 0
 			// Rebuild form
-			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.calendars)
+			m.eventForm = buildEventForm(m.formSummary, m.formDescription, m.formDate, m.formStartTime, m.formEndTime, m.formCalendar, m.formRepeatOptions, m.formRepeatEndDate, m.writableCalendars())
 			return m, m.eventForm.Init()
+		case "up", "k":
+			if m.viewMode == DailyView && m.selectedEvent > 0 {
+				m.selectedEvent--
+			}
+		case "down", "j":
+			if m.viewMode == DailyView && m.selectedEvent < len(m.getEventsForDay(m.currentDate))-1 {
+				m.selectedEvent++
+			}
 		case "left", "h":
+			m.selectedEvent = 0
 			if m.viewMode == DailyView {
 				m.currentDate = m.currentDate.AddDate(0, 0, -1)
 			} else if m.viewMode == WeeklyView {
 				m.currentDate = m.currentDate.AddDate(0, 0, -7)
 			} else if m.viewMode == MonthlyView {
 				m.currentDate = m.currentDate.AddDate(0, -1, 0)
+			} else if m.viewMode == AgendaView {
+				m.currentDate = m.currentDate.AddDate(0, 0, -m.agendaDays)
+				m.agendaViewport.GotoTop()
 			}
 			m.dayInput = ""
 		case "right", "l":
+			m.selectedEvent = 0
 			if m.viewMode == DailyView {
 				m.currentDate = m.currentDate.AddDate(0, 0, 1)
 			} else if m.viewMode == WeeklyView {
 				m.currentDate = m.currentDate.AddDate(0, 0, 7)
 			} else if m.viewMode == MonthlyView {
 				m.currentDate = m.currentDate.AddDate(0, 1, 0)
+			} else if m.viewMode == AgendaView {
+				m.currentDate = m.currentDate.AddDate(0, 0, m.agendaDays)
+				m.agendaViewport.GotoTop()
 			}
 			m.dayInput = ""
 		case "t":
 			m.currentDate = time.Now()
 			m.dayInput = ""
+			m.selectedEvent = 0
+		case "c":
+			m.durationColors = !m.durationColors
+			m.refreshAgenda()
+			if m.durationColors {
+				m.message = "Coloring events by duration"
+			} else {
+				m.message = "Coloring events by calendar"
+			}
+		case "/":
+			m.searchActive = true
+			m.searchInput = ""
+			m.searchSelected = 0
+			m.searchMatches = nil
+			m.dayInput = ""
+		case "g":
+			m.gotoActive = true
+			m.gotoInput = ""
+			m.gotoErr = ""
+			m.dayInput = ""
+		case "r":
+			if !m.isRefreshing {
+				m.isRefreshing = true
+				return m, tea.Batch(m.loadingSpinner.Tick, loadCalendarsCmd(m.radicaleConfig))
+			}
 		case "d":
 			m.viewMode = DailyView
 			m.dayInput = ""
@@ -281,7 +437,17 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "m":
 			m.viewMode = MonthlyView
 			m.dayInput = ""
+		case "A":
+			m.viewMode = AgendaView
+			m.dayInput = ""
 		case "enter":
+			if m.viewMode == DailyView {
+				dayEvents := m.getEventsForDay(m.currentDate)
+				if m.selectedEvent < len(dayEvents) {
+					event := dayEvents[m.selectedEvent]
+					m.detailEvent = &event
+				}
+			}
 			if m.viewMode == MonthlyView && m.dayInput != "" {
 				if day, err := strconv.Atoi(m.dayInput); err == nil && day >= 1 && day <= 31 {
 					lastDay := time.Date(m.currentDate.Year(), m.currentDate.Month()+1, 0, 0, 0, 0, 0, time.Local).Day()
@@ -303,6 +469,92 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "escape":
 			m.dayInput = ""
 		}
+
+		if m.viewMode == AgendaView {
+			m.refreshAgenda()
+		}
+	}
+	return m, nil
+}
+
+// handleGotoInput handles keys while the go to date prompt is open. The
+// date is parsed like --list, and the current view moves to contain it.
+func (m model) handleGotoInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.gotoActive = false
+	case "enter":
+		date, err := parseDateArg(m.gotoInput)
+		if err != nil {
+			m.gotoErr = fmt.Sprintf("Invalid date %q", m.gotoInput)
+			return m, nil
+		}
+		m.currentDate = date
+		m.selectedEvent = 0
+		m.gotoActive = false
+		if m.viewMode == AgendaView {
+			m.agendaViewport.GotoTop()
+			m.refreshAgenda()
+		}
+	case "backspace":
+		if len(m.gotoInput) > 0 {
+			m.gotoInput = m.gotoInput[:len(m.gotoInput)-1]
+		}
+		m.gotoErr = ""
+	default:
+		if len(msg.Runes) > 0 {
+			m.gotoInput += string(msg.Runes)
+			m.gotoErr = ""
+		}
+	}
+	return m, nil
+}
+
+// updateSearch recomputes the search results. Searching scans every day of
+// the search window, so it runs when the input or the events change, not
+// on every render.
+func (m *model) updateSearch() {
+	m.searchMatches = searchEvents(m.events, m.searchInput, time.Now())
+	if m.searchSelected >= len(m.searchMatches) {
+		m.searchSelected = max(len(m.searchMatches)-1, 0)
+	}
+}
+
+// handleSearchInput handles keys in the search view. Results update while
+// typing; enter opens the selected event, esc closes the search.
+func (m model) handleSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.searchActive = false
+	case "enter":
+		if m.searchSelected < len(m.searchMatches) {
+			event := m.searchMatches[m.searchSelected]
+			m.detailEvent = &event
+		}
+	case "up", "ctrl+k":
+		if m.searchSelected > 0 {
+			m.searchSelected--
+		}
+	case "down", "ctrl+j":
+		if m.searchSelected < len(m.searchMatches)-1 {
+			m.searchSelected++
+		}
+	case "backspace":
+		if len(m.searchInput) > 0 {
+			m.searchInput = m.searchInput[:len(m.searchInput)-1]
+		}
+		m.searchSelected = 0
+		m.updateSearch()
+	default:
+		if len(msg.Runes) > 0 {
+			m.searchInput += string(msg.Runes)
+			m.searchSelected = 0
+			m.updateSearch()
+		}
 	}
 	return m, nil
 }
@@ -352,6 +604,7 @@ func (m model) handleEventCreationInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					} else {
 						m.message = "Event created successfully!"
 						m.events = append(m.events, *event)
+						m.invalidateCache()
 						m.creationMode = NoCreation
 						m.naturalLangInput = ""
 					}
@@ -359,6 +612,7 @@ func (m model) handleEventCreationInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					// Save locally
 					m.events = append(m.events, *event)
 					m.message = "Event created successfully!"
+					m.invalidateCache()
 					m.creationMode = NoCreation
 					m.naturalLangInput = ""
 				}
@@ -518,12 +772,14 @@ func (m model) handleEventCreationInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					} else {
 						m.message = "Event created successfully!"
 						m.events = append(m.events, *event)
+						m.invalidateCache()
 						m.creationMode = NoCreation
 					}
 				} else {
 					// Save locally
 					m.events = append(m.events, *event)
 					m.message = "Event created successfully!"
+					m.invalidateCache()
 					m.creationMode = NoCreation
 				}
 			}
@@ -542,6 +798,14 @@ func (m model) View() string {
 		return m.viewEventForm()
 	}
 
+	if m.detailEvent != nil {
+		return m.viewEventDetail()
+	}
+
+	if m.searchActive {
+		return m.viewSearch()
+	}
+
 	// Render natural language input view
 	if m.creationMode == NaturalLanguageInput {
 		return m.viewNaturalLanguage()
@@ -555,6 +819,8 @@ func (m model) View() string {
 		return m.viewWeekly()
 	case MonthlyView:
 		return m.viewMonthly()
+	case AgendaView:
+		return m.viewAgenda()
 	default:
 		return ""
 	}
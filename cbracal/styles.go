@@ -1,6 +1,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -16,6 +18,29 @@ var calendarColors = []lipgloss.Color{
 	lipgloss.Color("211"), // Light Pink
 }
 
+// durationColors grade event lengths from cool to warm
+var durationColors = []struct {
+	max   time.Duration
+	color lipgloss.Color
+}{
+	{15 * time.Minute, lipgloss.Color("51")}, // Cyan
+	{30 * time.Minute, lipgloss.Color("45")}, // Turquoise
+	{time.Hour, lipgloss.Color("120")},       // Green
+	{2 * time.Hour, lipgloss.Color("226")},   // Yellow
+	{4 * time.Hour, lipgloss.Color("214")},   // Orange
+	{0, lipgloss.Color("203")},               // Red, anything longer
+}
+
+// durationColor returns the gradient color for an event of length d
+func durationColor(d time.Duration) lipgloss.Color {
+	for _, step := range durationColors {
+		if d <= step.max {
+			return step.color
+		}
+	}
+	return durationColors[len(durationColors)-1].color
+}
+
 // Styles
 var (
 	titleStyle = lipgloss.NewStyle().
@@ -34,6 +59,18 @@ var (
 			Foreground(lipgloss.Color("241")).
 			Bold(true)
 
+	conflictStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("214")).
+			Bold(true)
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196")).
+			Padding(0, 1)
+
+	countdownStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("120")).
+			Padding(0, 1)
+
 	noEventsStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("241")).
 			Italic(true).
@@ -2,10 +2,13 @@ package main
 
 import (
 	"encoding/xml"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -16,6 +19,7 @@ const (
 	DailyView ViewMode = iota
 	WeeklyView
 	MonthlyView
+	AgendaView
 )
 
 type EventCreationMode int
@@ -33,11 +37,15 @@ type loadingMsg struct {
 
 type loadingCompleteMsg struct{}
 
+// countdownTickMsg re-renders the next event countdown once a minute
+type countdownTickMsg struct{}
+
 type calendarsLoadedMsg struct {
 	events       []Event
 	calendars    map[string]lipgloss.Color
 	calendarURLs map[string]string
 	err          error
+	cacheErr     error // saving the event cache failed
 }
 
 type Event struct {
@@ -45,9 +53,12 @@ type Event struct {
 	Start         time.Time
 	End           time.Time
 	Description   string
+	Location      string
 	CalendarName  string
 	CalendarColor lipgloss.Color
 	UID           string // For Radicale sync
+	AllDay        bool   // DTSTART;VALUE=DATE events
+	Declined      bool   // One of the configured emails declined it
 }
 
 type CalendarConfig struct {
@@ -57,6 +68,20 @@ type CalendarConfig struct {
 	Type string `toml:"type,omitempty"` // "radicale", "url", "file", or empty for auto-detect
 }
 
+// SubscriptionConfig is a read-only calendar feed (webcal:// or https://...ics)
+type SubscriptionConfig struct {
+	Name string `toml:"name,omitempty"`
+	URL  string `toml:"url"`
+}
+
+// DisplayName returns the calendar name, falling back to the URL
+func (s SubscriptionConfig) DisplayName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.URL
+}
+
 type RadicaleConfig struct {
 	ServerURL string `toml:"server_url"`
 	Username  string `toml:"username"`
@@ -64,17 +89,86 @@ type RadicaleConfig struct {
 }
 
 type NotificationConfig struct {
-	Enabled        bool  `toml:"enabled"`
-	CheckInterval  int   `toml:"check_interval"`  // seconds between calendar checks
-	AdvanceNotice  []int `toml:"advance_notice"`  // minutes before event to notify
-	ReloadInterval int   `toml:"reload_interval"` // minutes between full calendar reloads
+	Enabled        bool          `toml:"enabled"`
+	CheckInterval  int           `toml:"check_interval"`  // seconds between calendar checks
+	AdvanceNotice  AdvanceNotice `toml:"advance_notice"`  // reminders before event to notify
+	ReloadInterval int           `toml:"reload_interval"` // minutes between full calendar reloads
+}
+
+// AdvanceNotice is the list of reminder offsets before an event. In the config
+// it can be a single value or a list, each either minutes (15) or a duration
+// string ("1h", "10m").
+type AdvanceNotice []time.Duration
+
+// UnmarshalTOML implements toml.Unmarshaler
+func (a *AdvanceNotice) UnmarshalTOML(data any) error {
+	values, ok := data.([]any)
+	if !ok {
+		values = []any{data}
+	}
+
+	var notices AdvanceNotice
+	for _, v := range values {
+		var d time.Duration
+		switch v := v.(type) {
+		case int64:
+			d = time.Duration(v) * time.Minute
+		case string:
+			var err error
+			d, err = time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("invalid advance_notice %q: %v", v, err)
+			}
+		default:
+			return fmt.Errorf("invalid advance_notice value: %v", v)
+		}
+
+		if d < 0 {
+			return fmt.Errorf("invalid advance_notice %v: must not be negative", v)
+		}
+		if d%time.Minute != 0 {
+			return fmt.Errorf("invalid advance_notice %v: must be whole minutes", v)
+		}
+		notices = append(notices, d)
+	}
+
+	*a = notices
+	return nil
+}
+
+// Minutes returns the reminder offsets in whole minutes, largest first, with
+// duplicates removed so each threshold fires only once per event. 0 is a
+// reminder at the start time.
+func (a AdvanceNotice) Minutes() []int {
+	seen := make(map[int]bool)
+	var minutes []int
+	for _, d := range a {
+		m := int(d.Minutes())
+		if m < 0 || seen[m] {
+			continue
+		}
+		seen[m] = true
+		minutes = append(minutes, m)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(minutes)))
+	return minutes
 }
 
 type Config struct {
-	Radicale       *RadicaleConfig     `toml:"radicale,omitempty"`
-	Calendars      []CalendarConfig    `toml:"calendars"`
-	LocalCalendars []string            `toml:"local_calendars,omitempty"`
-	Notifications  *NotificationConfig `toml:"notifications,omitempty"`
+	Radicale       *RadicaleConfig      `toml:"radicale,omitempty"`
+	Calendars      []CalendarConfig     `toml:"calendars"`
+	Subscriptions  []SubscriptionConfig `toml:"subscriptions,omitempty"`
+	LocalCalendars []string             `toml:"local_calendars,omitempty"`
+	Notifications  *NotificationConfig  `toml:"notifications,omitempty"`
+	Colors         map[string]string    `toml:"colors,omitempty"`          // calendar name -> hex color
+	WeekStart      string               `toml:"week_start,omitempty"`      // "monday" (default) or "sunday"
+	TimeFormat     string               `toml:"time_format,omitempty"`     // "24h" (default) or "12h"
+	CacheTTL       int                  `toml:"cache_ttl,omitempty"`       // minutes cached events are served to one-shot modes
+	Emails         []string             `toml:"emails,omitempty"`          // own addresses, to find declined invitations
+	ShowDeclined   bool                 `toml:"show_declined,omitempty"`   // strike declined events through instead of hiding them
+	DurationColors bool                 `toml:"duration_colors,omitempty"` // color events by length instead of by calendar
+	SearchDays     int                  `toml:"search_days,omitempty"`     // days ahead that --search and / look at
+	SearchDetails  bool                 `toml:"search_details,omitempty"`  // also match location and description
 }
 
 type CalDAVCalendar struct {
@@ -124,9 +218,20 @@ type model struct {
 	events           []Event
 	calendars        map[string]lipgloss.Color
 	calendarURLs     map[string]string // Map calendar name to Radicale URL
+	readOnly         map[string]bool   // Subscribed calendars events can't be created in
+	durationColors   bool              // Color events by length, see eventColor
 	currentDate      time.Time
 	viewMode         ViewMode
 	dayInput         string
+	gotoActive       bool // The go to date prompt is open
+	gotoInput        string
+	gotoErr          string
+	selectedEvent    int    // Index into the daily view's events
+	detailEvent      *Event // Event shown in the detail view, if open
+	searchActive     bool   // The search view is open
+	searchInput      string
+	searchSelected   int     // Index into the search results
+	searchMatches    []Event // Results for searchInput, see updateSearch
 	width            int
 	height           int
 	oneShot          bool
@@ -144,6 +249,8 @@ type model struct {
 	loadingSpinner  spinner.Model
 	isLoading       bool
 	loadingMessage  string
+	isRefreshing    bool      // Reloading in the background while showing cached data
+	cacheTime       time.Time // When the displayed events were fetched
 
 	// Form data (pointers for huh form)
 	formSummary       *string
@@ -155,4 +262,9 @@ type model struct {
 	formRepeatOptions *string // Single select for repeat option
 	formRepeatEndDate *string
 	formScrollOffset  int // For scrolling when content is too tall
+
+	// Agenda view
+	agendaDays      int
+	agendaSkipEmpty bool
+	agendaViewport  viewport.Model
 }
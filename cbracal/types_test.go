@@ -0,0 +1,48 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+func TestAdvanceNotice(t *testing.T) {
+	tests := []struct {
+		config string
+		want   []int
+	}{
+		{`advance_notice = 15`, []int{15}},
+		{`advance_notice = "1h"`, []int{60}},
+		{`advance_notice = [0, "10m", 60, "1h"]`, []int{60, 10, 0}},
+		{`advance_notice = 0`, []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.config, func(t *testing.T) {
+			var config NotificationConfig
+			if _, err := toml.Decode(tt.config, &config); err != nil {
+				t.Fatalf("decode error: %v", err)
+			}
+			if got := config.AdvanceNotice.Minutes(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Minutes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdvanceNoticeInvalid(t *testing.T) {
+	for _, config := range []string{
+		`advance_notice = -5`,
+		`advance_notice = "-10m"`,
+		`advance_notice = "90s"`,
+		`advance_notice = ["10m", "1m30s"]`,
+		`advance_notice = "soon"`,
+		`advance_notice = true`,
+	} {
+		var notif NotificationConfig
+		if _, err := toml.Decode(config, &notif); err == nil {
+			t.Errorf("%s: decoded without error", config)
+		}
+	}
+}
@@ -20,8 +20,8 @@ func (m model) viewNaturalLanguage() string {
 		if err == nil {
 			preview := fmt.Sprintf("Summary: %s\nStart: %s\nEnd: %s\nCalendar: %s",
 				event.Summary,
-				event.Start.Format("Mon Jan 2, 2006 15:04"),
-				event.End.Format("15:04"),
+				event.Start.Format("Mon Jan 2, 2006 ")+formatClock(event.Start),
+				formatClock(event.End),
 				m.selectedCalendar)
 			b.WriteString(eventBoxStyle.Width(60).Render(preview) + "\n")
 		} else {
@@ -124,7 +124,7 @@ func (m model) viewDaily() string {
 	var b strings.Builder
 
 	title := titleStyle.Render("📅 Daily View")
-	b.WriteString(title + "\n")
+	b.WriteString(title + m.renderCountdown() + "\n")
 
 	_, week := m.currentDate.ISOWeek()
 	dateHeader := dateHeaderStyle.Render(fmt.Sprintf(
@@ -152,30 +152,39 @@ func (m model) viewDaily() string {
 			}
 		}
 
-		for _, event := range dayEvents {
-			isNow := m.currentDate.Format("2006-01-02") == currentTime.Format("2006-01-02") &&
+		conflicts := conflictingEvents(dayEvents)
+		for i, event := range dayEvents {
+			isNow := !event.AllDay &&
+				m.currentDate.Format("2006-01-02") == currentTime.Format("2006-01-02") &&
 				currentTime.After(event.Start) && currentTime.Before(event.End)
 
 			var boxContent strings.Builder
 
 			timeStr := fmt.Sprintf("%s - %s",
-				event.Start.Format("15:04"),
-				event.End.Format("15:04"),
+				formatClock(event.Start),
+				formatClock(event.End),
 			)
 			duration := event.End.Sub(event.Start)
 			durationStr := ""
-			if duration >= time.Hour {
+			if event.AllDay {
+				timeStr = "All day"
+			} else if duration >= time.Hour {
 				durationStr = fmt.Sprintf(" (%.1fh)", duration.Hours())
 			} else if duration > 0 {
 				durationStr = fmt.Sprintf(" (%dm)", int(duration.Minutes()))
 			}
 
 			timeLineStyle := timeStyle.Foreground(lipgloss.Color("241"))
-			boxContent.WriteString(timeLineStyle.Render(timeStr+durationStr) + "\n")
+			boxContent.WriteString(timeLineStyle.Render(timeStr + durationStr))
+			if conflicts[i] {
+				boxContent.WriteString(" " + conflictStyle.Render("⚠ conflict"))
+			}
+			boxContent.WriteString("\n")
 
 			titleStyle := lipgloss.NewStyle().
-				Foreground(event.CalendarColor).
-				Bold(true)
+				Foreground(m.eventColor(event)).
+				Bold(true).
+				Strikethrough(event.Declined)
 			boxContent.WriteString(titleStyle.Render("● " + event.Summary))
 
 			if event.Description != "" && strings.TrimSpace(event.Description) != "" {
@@ -192,7 +201,7 @@ func (m model) viewDaily() string {
 			}
 
 			boxStyle := eventBoxStyle.
-				BorderForeground(event.CalendarColor).
+				BorderForeground(m.eventColor(event)).
 				Width(boxWidth)
 
 			if isNow {
@@ -200,6 +209,9 @@ func (m model) viewDaily() string {
 					BorderForeground(lipgloss.Color("205")).
 					BorderStyle(lipgloss.ThickBorder())
 			}
+			if !m.oneShot && i == m.selectedEvent {
+				boxStyle = boxStyle.BorderStyle(lipgloss.DoubleBorder())
+			}
 
 			b.WriteString(boxStyle.Render(boxContent.String()) + "\n")
 		}
@@ -207,7 +219,8 @@ func (m model) viewDaily() string {
 
 	if !m.oneShot {
 		b.WriteString(m.renderCalendarLegend())
-		b.WriteString("\n" + helpStyle.Render("d: daily  w: weekly  m: monthly  |  ← →: navigate  t: today  |  n: new event  |  q: quit"))
+		b.WriteString("\n" + helpStyle.Render("d: daily  w: weekly  m: monthly  A: agenda  |  ← →: navigate  ↑ ↓: select  enter: details  t: today  g: go to date  /: search  c: colors  r: refresh  |  n: new event  |  q: quit"))
+		b.WriteString(m.renderStatus())
 
 		if m.err != nil {
 			b.WriteString("\n" + helpStyle.Render("Note: Using sample data (no calendars found)"))
@@ -217,14 +230,65 @@ func (m model) viewDaily() string {
 	return b.String()
 }
 
+// viewEventDetail shows everything known about the selected event
+func (m model) viewEventDetail() string {
+	event := m.detailEvent
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render("📅 Event") + "\n\n")
+
+	summaryStyle := lipgloss.NewStyle().
+		Foreground(event.CalendarColor).
+		Bold(true).
+		Strikethrough(event.Declined)
+	b.WriteString(summaryStyle.Render("● "+event.Summary) + "\n\n")
+
+	timeStr := event.Start.Format("Mon Jan 2, ") + formatClock(event.Start) + " - " + formatClock(event.End)
+	if event.AllDay {
+		timeStr = event.Start.Format("Mon Jan 2") + ", All day"
+	} else if event.End.Format("2006-01-02") != event.Start.Format("2006-01-02") {
+		timeStr = event.Start.Format("Mon Jan 2, ") + formatClock(event.Start) + " - " + event.End.Format("Mon Jan 2, ") + formatClock(event.End)
+	}
+
+	rows := [][2]string{
+		{"When", timeStr},
+		{"Calendar", event.CalendarName},
+	}
+	if event.Location != "" {
+		rows = append(rows, [2]string{"Location", event.Location})
+	}
+	if event.Declined {
+		rows = append(rows, [2]string{"Response", "Declined"})
+	}
+	for _, row := range rows {
+		b.WriteString(fieldLabelStyle.Render(fmt.Sprintf("%-10s", row[0])) + row[1] + "\n")
+	}
+
+	if desc := strings.TrimSpace(event.Description); desc != "" {
+		width := 80
+		if m.width > 0 && m.width-4 < width {
+			width = m.width - 4
+		}
+		descStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("245")).
+			Width(width)
+		b.WriteString("\n" + descStyle.Render(desc) + "\n")
+	}
+
+	b.WriteString("\n" + helpStyle.Render("esc/enter: back  |  ctrl+c: quit"))
+
+	return b.String()
+}
+
 func (m model) viewWeekly() string {
 	var b strings.Builder
 
 	title := titleStyle.Render("📅 Weekly View")
-	b.WriteString(title + "\n")
+	b.WriteString(title + m.renderCountdown() + "\n")
 
 	weekStart := m.getWeekStart(m.currentDate)
-	_, week := weekStart.ISOWeek()
+	// Midweek day gives the right ISO week for both Monday and Sunday starts
+	_, week := weekStart.AddDate(0, 0, 3).ISOWeek()
 
 	dateHeader := dateHeaderStyle.Render(fmt.Sprintf(
 		"Week %d - %s to %s",
@@ -234,14 +298,21 @@ func (m model) viewWeekly() string {
 	))
 	b.WriteString(dateHeader + "\n")
 
+	today := time.Now()
 	for i := 0; i < 7; i++ {
 		day := weekStart.AddDate(0, 0, i)
 		dayEvents := m.getEventsForDay(day)
 
+		headerText := day.Format("Monday, Jan 2")
+		headerColor := lipgloss.Color("117")
+		if day.Format("2006-01-02") == today.Format("2006-01-02") {
+			headerText += " (today)"
+			headerColor = lipgloss.Color("205")
+		}
 		dayHeader := lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("117")).
-			Render(day.Format("Monday, Jan 2"))
+			Foreground(headerColor).
+			Render(headerText)
 
 		b.WriteString("\n" + dayHeader + "\n")
 
@@ -249,54 +320,151 @@ func (m model) viewWeekly() string {
 			b.WriteString(noEventsStyle.Render("  No events") + "\n")
 		} else {
 			for _, event := range dayEvents {
-				timeStr := fmt.Sprintf("  %s - %s",
-					event.Start.Format("15:04"),
-					event.End.Format("15:04"),
-				)
-				b.WriteString(timeStyle.Render(timeStr))
-
-				eventStyle := lipgloss.NewStyle().
-					Foreground(event.CalendarColor).
-					MarginLeft(2)
-
-				b.WriteString(eventStyle.Render(fmt.Sprintf("● %s", event.Summary)))
-				b.WriteString("\n")
+				b.WriteString(renderEventLine(event, m.eventColor(event), false) + "\n")
 			}
 		}
 	}
 
 	if !m.oneShot {
 		b.WriteString(m.renderCalendarLegend())
-		b.WriteString("\n" + helpStyle.Render("d: daily  w: weekly  m: monthly  |  ← →: navigate  t: today  |  n: new event  |  q: quit"))
+		b.WriteString("\n" + helpStyle.Render("d: daily  w: weekly  m: monthly  A: agenda  |  ← →: navigate  t: today  g: go to date  /: search  c: colors  r: refresh  |  n: new event  |  q: quit"))
+		b.WriteString(m.renderStatus())
 	}
 
 	return b.String()
 }
 
+func (m model) viewAgenda() string {
+	var b strings.Builder
+
+	title := titleStyle.Render("📅 Agenda")
+	b.WriteString(title + m.renderCountdown() + "\n")
+
+	lastDay := m.currentDate.AddDate(0, 0, m.agendaDays-1)
+	dateHeader := dateHeaderStyle.Render(fmt.Sprintf(
+		"Next %d days - %s to %s",
+		m.agendaDays,
+		m.currentDate.Format("Jan 2"),
+		lastDay.Format("Jan 2, 2006"),
+	))
+	b.WriteString(dateHeader + "\n")
+
+	// One-shot output prints everything, interactive mode scrolls
+	if m.oneShot {
+		b.WriteString(m.renderAgendaContent())
+		return b.String()
+	}
+
+	b.WriteString(m.agendaViewport.View() + "\n")
+	b.WriteString(m.renderCalendarLegend())
+
+	emptyHelp := "e: hide empty days"
+	if m.agendaSkipEmpty {
+		emptyHelp = "e: show empty days"
+	}
+	b.WriteString("\n" + helpStyle.Render("d: daily  w: weekly  m: monthly  A: agenda  |  ↑ ↓: scroll  ← →: navigate  t: today  g: go to date  /: search  c: colors  r: refresh  |  "+emptyHelp+"  |  q: quit"))
+	b.WriteString(m.renderStatus())
+
+	return b.String()
+}
+
+// renderAgendaContent renders the events of the agenda range grouped by day
+func (m model) renderAgendaContent() string {
+	var b strings.Builder
+	today := time.Now()
+
+	for i := 0; i < m.agendaDays; i++ {
+		day := m.currentDate.AddDate(0, 0, i)
+		dayEvents := m.getEventsForDay(day)
+
+		if len(dayEvents) == 0 && m.agendaSkipEmpty {
+			continue
+		}
+
+		headerText := day.Format("Monday, Jan 2")
+		if day.Format("2006-01-02") == today.Format("2006-01-02") {
+			headerText += " (today)"
+		}
+		dayHeader := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("117")).
+			Render(headerText)
+
+		b.WriteString("\n" + dayHeader + "\n")
+
+		if len(dayEvents) == 0 {
+			b.WriteString(noEventsStyle.Render("  No events") + "\n")
+			continue
+		}
+		conflicts := conflictingEvents(dayEvents)
+		for i, event := range dayEvents {
+			b.WriteString(renderEventLine(event, m.eventColor(event), conflicts[i]) + "\n")
+		}
+	}
+
+	if b.Len() == 0 {
+		return noEventsStyle.Render("No events in this period") + "\n"
+	}
+
+	return b.String()
+}
+
+// eventColor is the calendar color of an event, or its duration color when
+// duration colors are on. Configured calendar colors and all-day events keep
+// the calendar color.
+func (m model) eventColor(event Event) lipgloss.Color {
+	if !m.durationColors || event.AllDay || configuredColors[event.CalendarName] {
+		return event.CalendarColor
+	}
+	return durationColor(event.End.Sub(event.Start))
+}
+
+// renderEventLine renders a single "time ● summary" line for the list-style
+// views in the given color, with a warning marker if the event overlaps
+// another one
+func renderEventLine(event Event, color lipgloss.Color, conflict bool) string {
+	timeStr := fmt.Sprintf("%s - %s",
+		formatClock(event.Start),
+		formatClock(event.End),
+	)
+	if event.AllDay {
+		timeStr = "All day"
+	}
+	timeStr = fmt.Sprintf("  %-*s", timeRangeWidth(), timeStr)
+
+	eventStyle := lipgloss.NewStyle().
+		Foreground(color).
+		MarginLeft(2).
+		Strikethrough(event.Declined)
+
+	line := timeStyle.Render(timeStr) + eventStyle.Render(fmt.Sprintf("● %s", event.Summary))
+	if conflict {
+		line += " " + conflictStyle.Render("⚠ conflict")
+	}
+	return line
+}
+
 func (m model) viewMonthly() string {
 	var b strings.Builder
 
 	title := titleStyle.Render("📅 Monthly View")
-	b.WriteString(title + "\n")
+	b.WriteString(title + m.renderCountdown() + "\n")
 
 	dateHeader := dateHeaderStyle.Render(m.currentDate.Format("January 2006"))
 	b.WriteString(dateHeader + "\n")
 
-	weekdays := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
 	var headerRow strings.Builder
-	for _, day := range weekdays {
-		headerRow.WriteString(weekdayHeaderStyle.Render(day))
+	for i := 0; i < 7; i++ {
+		weekday := time.Weekday((int(weekStartDay) + i) % 7)
+		headerRow.WriteString(weekdayHeaderStyle.Render(weekday.String()[:3]))
 	}
 	b.WriteString(headerRow.String() + "\n")
 
 	firstDay := time.Date(m.currentDate.Year(), m.currentDate.Month(), 1, 0, 0, 0, 0, time.Local)
 	lastDay := time.Date(m.currentDate.Year(), m.currentDate.Month()+1, 0, 0, 0, 0, 0, time.Local)
 
-	startWeekday := int(firstDay.Weekday())
-	if startWeekday == 0 {
-		startWeekday = 7
-	}
-	startWeekday--
+	// Column of the first day of the month relative to the configured week start
+	startWeekday := (int(firstDay.Weekday()) - int(weekStartDay) + 7) % 7
 
 	day := 1
 	today := time.Now()
@@ -325,7 +493,8 @@ func (m model) viewMonthly() string {
 		if m.dayInput != "" {
 			b.WriteString("\n" + helpStyle.Render(fmt.Sprintf("Jump to day: %s (press Enter)", m.dayInput)))
 		}
-		b.WriteString("\n" + helpStyle.Render("d: daily  w: weekly  m: monthly  |  ← →: navigate  t: today  |  0-9 + Enter: jump  |  n: new event  |  q: quit"))
+		b.WriteString("\n" + helpStyle.Render("d: daily  w: weekly  m: monthly  A: agenda  |  ← →: navigate  t: today  g: go to date  /: search  c: colors  r: refresh  |  0-9 + Enter: jump  |  n: new event  |  q: quit"))
+		b.WriteString(m.renderStatus())
 	}
 
 	return b.String()
@@ -346,8 +515,10 @@ func (m model) renderMonthCell(date time.Time, today time.Time) string {
 	dayEvents := m.getEventsForDay(date)
 
 	for _, event := range dayEvents {
-		duration := event.End.Sub(event.Start)
-		durationPerCalendar[event.CalendarName] += duration
+		// All-day events would always max out the bar, so they only mark presence
+		if !event.AllDay {
+			durationPerCalendar[event.CalendarName] += event.End.Sub(event.Start)
+		}
 		hasEventsPerCalendar[event.CalendarName] = true
 	}
 
@@ -400,6 +571,122 @@ func (m model) renderMonthCell(date time.Time, today time.Time) string {
 	return style.Render(content.String())
 }
 
+// viewSearch shows the search input and the matching events
+func (m model) viewSearch() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render("🔎 Search") + "\n\n")
+	b.WriteString(inputStyle.Render(fmt.Sprintf(" %s█", m.searchInput)) + "\n\n")
+
+	results := m.searchMatches
+	switch {
+	case strings.TrimSpace(m.searchInput) == "":
+		b.WriteString(noEventsStyle.Render(fmt.Sprintf("Type to search the next %d days", searchDays)) + "\n")
+	case len(results) == 0:
+		b.WriteString(noEventsStyle.Render("No matching events") + "\n")
+	default:
+		// Keep the selection on screen
+		maxRows := len(results)
+		if m.height > 0 && m.height-10 < maxRows {
+			maxRows = max(m.height-10, 1)
+		}
+		first := max(m.searchSelected-maxRows+1, 0)
+		for i := first; i < len(results) && i < first+maxRows; i++ {
+			event := results[i]
+			cursor := "  "
+			if i == m.searchSelected {
+				cursor = selectedFieldStyle.Render("▶ ")
+			}
+			date := timeStyle.Render(event.Start.Format("Mon Jan 2"))
+			b.WriteString(cursor + date + renderEventLine(event, m.eventColor(event), false) + "\n")
+		}
+		if len(results) > maxRows {
+			b.WriteString(noEventsStyle.Render(fmt.Sprintf("%d matches", len(results))) + "\n")
+		}
+	}
+
+	b.WriteString(helpStyle.Render("type to search  |  ↑ ↓: select  enter: details  esc: close"))
+	return b.String()
+}
+
+// countdownNowWindow is how long a starting event shows "now" before the
+// countdown moves on to the following one
+const countdownNowWindow = time.Minute
+
+// renderCountdown returns the time left until the next timed event for the
+// view title line, or "" if nothing is coming up. One-shot output has no
+// countdown.
+func (m model) renderCountdown() string {
+	if m.oneShot {
+		return ""
+	}
+
+	now := time.Now()
+	var timed []Event
+	for _, event := range m.events {
+		if event.Declined || event.AllDay {
+			continue
+		}
+		if !event.Start.After(now) && now.Sub(event.Start) < countdownNowWindow {
+			return countdownStyle.Render(fmt.Sprintf("⏱ %s now", event.Summary))
+		}
+		timed = append(timed, event)
+	}
+
+	next := getNextEvent(timed)
+	if next == nil {
+		return ""
+	}
+	return countdownStyle.Render(fmt.Sprintf("⏱ %s %s", next.Summary, formatCountdown(next.Start.Sub(now))))
+}
+
+// formatCountdown formats the time until an event, rounding up to whole
+// minutes so "in 1m" is shown until it starts
+func formatCountdown(d time.Duration) string {
+	minutes := int((d + time.Minute - 1) / time.Minute)
+	switch {
+	case minutes < 60:
+		return fmt.Sprintf("in %dm", minutes)
+	case minutes < 24*60:
+		if minutes%60 == 0 {
+			return fmt.Sprintf("in %dh", minutes/60)
+		}
+		return fmt.Sprintf("in %dh %dm", minutes/60, minutes%60)
+	default:
+		return fmt.Sprintf("in %dd", minutes/(24*60))
+	}
+}
+
+// renderStatus shows the go to date prompt, the background refresh state
+// and the last message
+func (m model) renderStatus() string {
+	if m.gotoActive {
+		prompt := "\n" + helpStyle.Render(fmt.Sprintf("Go to date: %s█  (YYYY-MM-DD, today, tomorrow | Enter: go  Esc: cancel)", m.gotoInput))
+		if m.gotoErr != "" {
+			prompt += "\n" + errorStyle.Render(m.gotoErr)
+		}
+		return prompt
+	}
+
+	var status string
+	if m.isRefreshing {
+		status = m.loadingSpinner.View() + " Refreshing calendars..."
+		if !m.cacheTime.IsZero() {
+			status += fmt.Sprintf(" (showing cache from %s)", formatClock(m.cacheTime))
+		}
+	}
+	if m.message != "" {
+		if status != "" {
+			status += "  |  "
+		}
+		status += m.message
+	}
+	if status == "" {
+		return ""
+	}
+	return "\n" + helpStyle.Render(status)
+}
+
 func (m model) renderCalendarLegend() string {
 	var b strings.Builder
 	b.WriteString(calendarLabelStyle.Render("Calendars:") + "\n")
@@ -409,30 +696,27 @@ func (m model) renderCalendarLegend() string {
 			Padding(0, 1)
 		b.WriteString(legendStyle.Render(fmt.Sprintf("● %s", name)))
 	}
+	if m.durationColors {
+		b.WriteString("\n" + calendarLabelStyle.Render("Duration:") + "\n")
+		for _, step := range durationColors {
+			label := "longer"
+			if step.max > 0 {
+				label = "≤ " + formatDuration(step.max)
+			}
+			legendStyle := lipgloss.NewStyle().
+				Foreground(step.color).
+				Padding(0, 1)
+			b.WriteString(legendStyle.Render("● " + label))
+		}
+	}
 	return b.String()
 }
 
 func (m model) getEventsForDay(date time.Time) []Event {
-	var dayEvents []Event
-	for _, event := range m.events {
-		if event.Start.Year() == date.Year() &&
-			event.Start.Month() == date.Month() &&
-			event.Start.Day() == date.Day() {
-			dayEvents = append(dayEvents, event)
-		}
-	}
-
-	sort.Slice(dayEvents, func(i, j int) bool {
-		return dayEvents[i].Start.Before(dayEvents[j].Start)
-	})
-
-	return dayEvents
+	return getEventsForDay(m.events, date)
 }
 
 func (m model) getWeekStart(date time.Time) time.Time {
-	weekday := int(date.Weekday())
-	if weekday == 0 {
-		weekday = 7
-	}
-	return date.AddDate(0, 0, -(weekday - 1))
+	offset := (int(date.Weekday()) - int(weekStartDay) + 7) % 7
+	return date.AddDate(0, 0, -offset)
 }
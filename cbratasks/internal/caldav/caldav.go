@@ -16,6 +16,9 @@ import (
 
 const collectionName = "cbratasks"
 
+// pinnedProperty stores Task.Pinned in the VTODO
+const pinnedProperty = "X-CBRATASKS-PINNED"
+
 type Client struct {
 	baseURL  string
 	username string
@@ -207,11 +210,27 @@ func (c *Client) DeleteTask(id string) error {
 
 // taskToVTODO converts a Task to iCalendar VTODO format
 func taskToVTODO(t *task.Task) string {
+	return ToICS([]*task.Task{t})
+}
+
+// ToICS serializes tasks as VTODOs in a single VCALENDAR, e.g. for
+// exporting to a .ics file
+func ToICS(tasks []*task.Task) string {
 	var b strings.Builder
 
 	b.WriteString("BEGIN:VCALENDAR\r\n")
 	b.WriteString("VERSION:2.0\r\n")
 	b.WriteString("PRODID:-//cbratasks//EN\r\n")
+	for _, t := range tasks {
+		writeVTODO(&b, t)
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+
+	return b.String()
+}
+
+// writeVTODO writes a single VTODO component for t
+func writeVTODO(b *strings.Builder, t *task.Task) {
 	b.WriteString("BEGIN:VTODO\r\n")
 
 	// UID
@@ -255,10 +274,12 @@ func taskToVTODO(t *task.Task) string {
 		b.WriteString(fmt.Sprintf("CATEGORIES:%s\r\n", strings.Join(t.Tags, ",")))
 	}
 
-	b.WriteString("END:VTODO\r\n")
-	b.WriteString("END:VCALENDAR\r\n")
+	// Pinned - custom property, other clients ignore it
+	if t.Pinned {
+		b.WriteString(pinnedProperty + ":TRUE\r\n")
+	}
 
-	return b.String()
+	b.WriteString("END:VTODO\r\n")
 }
 
 // parseMultistatusResponse parses a CalDAV multistatus response
@@ -349,6 +370,8 @@ func vtodoToTask(ical string) (*task.Task, error) {
 		} else if strings.HasPrefix(line, "CATEGORIES:") {
 			cats := strings.TrimPrefix(line, "CATEGORIES:")
 			t.Tags = strings.Split(cats, ",")
+		} else if strings.HasPrefix(line, pinnedProperty+":") {
+			t.Pinned = strings.EqualFold(strings.TrimPrefix(line, pinnedProperty+":"), "TRUE")
 		} else if strings.HasPrefix(line, "CREATED:") {
 			created := parseICalTime(strings.TrimPrefix(line, "CREATED:"))
 			if created != nil {
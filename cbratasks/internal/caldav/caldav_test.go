@@ -0,0 +1,41 @@
+package caldav
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cbratasks/internal/task"
+)
+
+func TestVTODORoundTripKeepsPinned(t *testing.T) {
+	due := time.Date(2026, 10, 23, 23, 59, 59, 0, time.UTC)
+	for _, pinned := range []bool{true, false} {
+		orig := &task.Task{
+			ID:        "task-1",
+			Title:     "Write report",
+			Tags:      []string{"work"},
+			DueDate:   &due,
+			Pinned:    pinned,
+			CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC),
+		}
+
+		var b strings.Builder
+		writeVTODO(&b, orig)
+		got, err := vtodoToTask(b.String())
+		if err != nil {
+			t.Fatalf("vtodoToTask() error: %v", err)
+		}
+
+		if got.Pinned != pinned {
+			t.Errorf("Pinned = %v after round trip, want %v", got.Pinned, pinned)
+		}
+		if got.ID != orig.ID || got.Title != orig.Title {
+			t.Errorf("round trip = %q %q, want %q %q", got.ID, got.Title, orig.ID, orig.Title)
+		}
+		if got.DueDate == nil || !got.DueDate.Equal(due) {
+			t.Errorf("DueDate = %v after round trip, want %v", got.DueDate, due)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 )
@@ -14,8 +15,13 @@ type Config struct {
 	GitHub      GitHubConfig      `toml:"github"`
 	Tags        map[string]string `toml:"tags"` // tag name -> color
 	Hotkeys     HotkeyConfig      `toml:"hotkeys"`
+	DataDir     string            `toml:"data_dir,omitempty"`  // overrides the default data directory
+	SortMode    string            `toml:"sort_mode,omitempty"` // "due", "title" or "created"
 }
 
+// dataDirEnv overrides both the default data directory and data_dir
+const dataDirEnv = "CBRATASKS_DATA_DIR"
+
 type SyncConfig struct {
 	Enabled  bool   `toml:"enabled"`
 	URL      string `toml:"url"`
@@ -88,6 +94,27 @@ func DataDir() string {
 	return filepath.Join(ConfigDir(), "data")
 }
 
+// ResolveDataDir returns where tasks and the archive are stored:
+// $CBRATASKS_DATA_DIR if set, then data_dir from the config, then DataDir()
+func (c *Config) ResolveDataDir() string {
+	if dir := os.Getenv(dataDirEnv); dir != "" {
+		return expandHome(dir)
+	}
+	if c.DataDir != "" {
+		return expandHome(c.DataDir)
+	}
+	return DataDir()
+}
+
+// expandHome replaces a leading ~ with the user's home directory
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, _ := os.UserHomeDir()
+		return filepath.Join(home, path[1:])
+	}
+	return path
+}
+
 func Exists() bool {
 	_, err := os.Stat(ConfigPath())
 	return err == nil
@@ -37,7 +37,7 @@ func New() (*Storage, error) {
 }
 
 func NewWithConfig(cfg *config.Config) (*Storage, error) {
-	dataDir := config.DataDir()
+	dataDir := cfg.ResolveDataDir()
 	if err := os.MkdirAll(dataDir, 0o755); err != nil {
 		return nil, err
 	}
@@ -132,7 +132,7 @@ func (s *Storage) GetTasks() []*task.Task {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	// Sort by: incomplete first, then by due date, then by created date
+	// Sort by: incomplete first, pinned, then by due date, then by created date
 	tasks := make([]*task.Task, len(s.tasks))
 	copy(tasks, s.tasks)
 
@@ -142,6 +142,11 @@ func (s *Storage) GetTasks() []*task.Task {
 			return !tasks[i].Completed
 		}
 
+		// Pinned tasks above the rest, unless they're done
+		if !tasks[i].Completed && tasks[i].Pinned != tasks[j].Pinned {
+			return tasks[i].Pinned
+		}
+
 		// Sort by due date (tasks with due dates first)
 		if tasks[i].DueDate != nil && tasks[j].DueDate != nil {
 			if !tasks[i].DueDate.Equal(*tasks[j].DueDate) {
@@ -300,6 +305,38 @@ func (s *Storage) Search(query string) []*task.Task {
 	return results
 }
 
+// SearchArchived returns archived tasks whose title fuzzy-matches query and
+// that carry tag. Empty arguments don't filter.
+func (s *Storage) SearchArchived(query, tag string) []*task.Task {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []*task.Task
+	query = strings.ToLower(query)
+
+	for _, t := range s.archived {
+		if query != "" && !fuzzyMatch(strings.ToLower(t.Title), query) {
+			continue
+		}
+		if tag != "" && !hasTag(t, tag) {
+			continue
+		}
+		results = append(results, t)
+	}
+
+	return results
+}
+
+// hasTag reports whether t has tag, ignoring case
+func hasTag(t *task.Task, tag string) bool {
+	for _, existing := range t.Tags {
+		if strings.EqualFold(existing, tag) {
+			return true
+		}
+	}
+	return false
+}
+
 // fuzzyMatch performs a simple fuzzy match
 func fuzzyMatch(str, pattern string) bool {
 	patternIdx := 0
@@ -318,20 +355,49 @@ func (s *Storage) IsSyncEnabled() bool {
 
 // Sync synchronizes tasks with the CalDAV server
 func (s *Storage) Sync() error {
+	_, err := s.SyncWithProgress(nil)
+	return err
+}
+
+// SyncProgress counts what a sync has done so far
+type SyncProgress struct {
+	Pulled int // tasks fetched from the server
+	Pushed int // local tasks uploaded to the server
+}
+
+// SyncWithProgress syncs like Sync and sends the running counts on progress
+// after the fetch and after each pushed task. progress may be nil; otherwise
+// it is closed when the sync returns. Sends never block, since the storage
+// lock is held while pushing, so an update is skipped when the buffer is
+// full. The final counts are returned.
+func (s *Storage) SyncWithProgress(progress chan<- SyncProgress) (SyncProgress, error) {
+	var counts SyncProgress
+	if progress != nil {
+		defer close(progress)
+	}
+	report := func() {
+		select {
+		case progress <- counts:
+		default:
+		}
+	}
+
 	if s.caldav == nil {
-		return fmt.Errorf("sync not enabled")
+		return counts, fmt.Errorf("sync not enabled")
 	}
 
 	// Ensure collection exists
 	if err := s.caldav.EnsureCollection(); err != nil {
-		return fmt.Errorf("failed to ensure collection: %w", err)
+		return counts, fmt.Errorf("failed to ensure collection: %w", err)
 	}
 
 	// Pull remote tasks
 	remoteTasks, err := s.caldav.GetAllTasks()
 	if err != nil {
-		return fmt.Errorf("failed to fetch remote tasks: %w", err)
+		return counts, fmt.Errorf("failed to fetch remote tasks: %w", err)
 	}
+	counts.Pulled = len(remoteTasks)
+	report()
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -380,13 +446,16 @@ func (s *Storage) Sync() error {
 			if err := s.caldav.CreateTask(local); err != nil {
 				// Log but continue
 				fmt.Printf("Warning: failed to push task %s: %v\n", local.Title, err)
+			} else {
+				counts.Pushed++
+				report()
 			}
 			mergedTasks = append(mergedTasks, local)
 		}
 	}
 
 	s.tasks = mergedTasks
-	return s.save()
+	return counts, s.save()
 }
 
 // PushTask pushes a single task to the CalDAV server
@@ -519,6 +588,63 @@ func (s *Storage) DeleteTaskWithSync(id string) error {
 	return nil
 }
 
+// MoveTask moves a task to another list. Moving to radicale pushes the task
+// to CalDAV and moving away deletes the remote copy. The local task only
+// changes once the server call succeeded, so a failure leaves it untouched.
+func (s *Storage) MoveTask(id, listName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var t *task.Task
+	for _, existing := range s.tasks {
+		if existing.ID == id {
+			t = existing
+			break
+		}
+	}
+	if t == nil {
+		return fmt.Errorf("task not found")
+	}
+	if t.ListName == listName {
+		return nil
+	}
+
+	fromRadicale := t.ListName == "radicale"
+	toRadicale := listName == "radicale"
+	if (fromRadicale || toRadicale) && s.caldav == nil {
+		return fmt.Errorf("sync not enabled")
+	}
+
+	moved := *t
+	moved.ListName = listName
+	moved.UpdatedAt = time.Now()
+
+	if toRadicale {
+		if err := s.PushTask(&moved); err != nil {
+			return fmt.Errorf("failed to push task: %w", err)
+		}
+	} else if fromRadicale {
+		if err := s.DeleteRemoteTask(id); err != nil {
+			return fmt.Errorf("failed to delete remote task: %w", err)
+		}
+	}
+
+	previous := *t
+	*t = moved
+	if err := s.save(); err != nil {
+		// Undo the server change so local and remote agree again
+		*t = previous
+		if toRadicale {
+			s.DeleteRemoteTask(id)
+		} else if fromRadicale {
+			s.PushTask(t)
+		}
+		return err
+	}
+
+	return nil
+}
+
 // ArchiveTask manually archives a single task by ID (only if completed)
 // This is a local operation - the task remains on the server for other clients
 func (s *Storage) ArchiveTask(id string) error {
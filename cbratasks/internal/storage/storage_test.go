@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"cbratasks/internal/task"
+)
+
+func TestGetTasksPinnedFirst(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	early := now.AddDate(0, 0, 1)
+	late := now.AddDate(0, 0, 7)
+
+	s := &Storage{tasks: []*task.Task{
+		{ID: "done-pinned", Title: "done pinned", Pinned: true, Completed: true, DueDate: &early, CreatedAt: now},
+		{ID: "early", Title: "early", DueDate: &early, CreatedAt: now},
+		{ID: "pinned", Title: "pinned", Pinned: true, DueDate: &late, CreatedAt: now},
+		{ID: "done", Title: "done", Completed: true, CreatedAt: now},
+	}}
+
+	var got []string
+	for _, t := range s.GetTasks() {
+		got = append(got, t.ID)
+	}
+
+	// The pinned task beats an earlier due date, but once done it sorts
+	// with the other completed tasks
+	want := []string{"pinned", "early", "done-pinned", "done"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("GetTasks() order = %v, want %v", got, want)
+		}
+	}
+}
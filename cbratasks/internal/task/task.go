@@ -22,7 +22,8 @@ type Task struct {
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 	Archived    bool       `json:"archived"`
-	ListName    string     `json:"list_name"` // "local" or "radicale"
+	Pinned      bool       `json:"pinned,omitempty"` // Pinned tasks are listed first
+	ListName    string     `json:"list_name"`        // "local" or "radicale"
 }
 
 // NewTask creates a new task with the given title
@@ -37,6 +38,19 @@ func NewTask(title string, listName string) *Task {
 	}
 }
 
+// Duplicate returns a new, incomplete task with the same title, note, tags,
+// due date and list
+func (t *Task) Duplicate() *Task {
+	dup := NewTask(t.Title, t.ListName)
+	dup.Note = t.Note
+	dup.Tags = append([]string(nil), t.Tags...)
+	if t.DueDate != nil {
+		due := *t.DueDate
+		dup.DueDate = &due
+	}
+	return dup
+}
+
 // Complete marks a task as completed
 func (t *Task) Complete() {
 	now := time.Now()
@@ -61,6 +75,12 @@ func (t *Task) ToggleComplete() {
 	}
 }
 
+// TogglePin toggles whether the task is pinned to the top
+func (t *Task) TogglePin() {
+	t.Pinned = !t.Pinned
+	t.UpdatedAt = time.Now()
+}
+
 // AddTag adds a tag to the task
 func (t *Task) AddTag(tag string) {
 	tag = strings.ToLower(strings.TrimSpace(tag))
@@ -159,11 +179,27 @@ func (t *Task) DueString() string {
 	return due.Format("02 Jan")
 }
 
+// weekdays maps day names and their three letter abbreviations
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
 // ParseDueDate parses various date formats into a time.Time
-// Supports: +1d, +3d, +1w, +2w, tomorrow, nextweek, DD-MM-YYYY
+// Supports: +1d, +3d, +1w, +2w, tomorrow, nextweek, friday, next friday,
+// DD-MM-YYYY
 func ParseDueDate(input string) (*time.Time, error) {
+	return parseDueDate(input, time.Now())
+}
+
+// parseDueDate resolves input relative to now
+func parseDueDate(input string, now time.Time) (*time.Time, error) {
 	input = strings.ToLower(strings.TrimSpace(input))
-	now := time.Now()
 
 	// Relative dates: +1d, +3d, +1w, 1d, 3d, 1w etc. (with or without +)
 	relativeRegex := regexp.MustCompile(`^\+?(\d+)([dwm])$`)
@@ -205,6 +241,25 @@ func ParseDueDate(input string) (*time.Time, error) {
 		return &result, nil
 	}
 
+	// Weekdays: "friday" is the coming Friday (a week away when today is
+	// Friday), "next friday" or "nextfriday" the one after that
+	day, skipWeek := input, false
+	if rest, ok := strings.CutPrefix(day, "next"); ok {
+		day, skipWeek = strings.TrimLeft(rest, " -"), true
+	}
+	if weekday, ok := weekdays[day]; ok {
+		days := (int(weekday) - int(now.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		if skipWeek {
+			days += 7
+		}
+		result := now.AddDate(0, 0, days)
+		result = time.Date(result.Year(), result.Month(), result.Day(), 23, 59, 59, 0, result.Location())
+		return &result, nil
+	}
+
 	// Specific date: DD-MM-YYYY
 	if t, err := time.Parse("02-01-2006", input); err == nil {
 		t = time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 0, now.Location())
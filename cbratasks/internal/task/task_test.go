@@ -0,0 +1,57 @@
+package task
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDueDate(t *testing.T) {
+	// A Friday
+	now := time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"today", "2026-10-16"},
+		{"tomorrow", "2026-10-17"},
+		{"+3d", "2026-10-19"},
+		{"1w", "2026-10-23"},
+		{"friday", "2026-10-23"},
+		{"Friday", "2026-10-23"},
+		{"fri", "2026-10-23"},
+		{"next friday", "2026-10-30"},
+		{"nextfriday", "2026-10-30"},
+		{"monday", "2026-10-19"},
+		{"mon", "2026-10-19"},
+		{"next mon", "2026-10-26"},
+		{"saturday", "2026-10-17"},
+		{"nextweek", "2026-10-19"},
+		{"24-12-2026", "2026-12-24"},
+		{"2026-12-24", "2026-12-24"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseDueDate(tt.input, now)
+			if err != nil {
+				t.Fatalf("parseDueDate(%q) error: %v", tt.input, err)
+			}
+			if date := got.Format("2006-01-02"); date != tt.want {
+				t.Errorf("parseDueDate(%q) = %s, want %s", tt.input, date, tt.want)
+			}
+			if got.Hour() != 23 || got.Minute() != 59 {
+				t.Errorf("parseDueDate(%q) = %v, want end of day", tt.input, got)
+			}
+		})
+	}
+}
+
+func TestParseDueDateInvalid(t *testing.T) {
+	now := time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC)
+	for _, input := range []string{"", "someday", "next", "32-01-2026"} {
+		if _, err := parseDueDate(input, now); err == nil {
+			t.Errorf("parseDueDate(%q) succeeded, want error", input)
+		}
+	}
+}
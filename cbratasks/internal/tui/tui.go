@@ -6,6 +6,7 @@ package tui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -49,6 +50,12 @@ type initialSyncDoneMsg struct {
 	err error
 }
 
+// syncProgressMsg carries running counts from a sync, ch delivers the next one
+type syncProgressMsg struct {
+	progress storage.SyncProgress
+	ch       <-chan storage.SyncProgress
+}
+
 type issuesLoadedMsg struct {
 	err error
 }
@@ -114,6 +121,11 @@ type listKeyMap struct {
 	Delete      key.Binding
 	AddTask     key.Binding
 	EditTask    key.Binding
+	Pin         key.Binding
+	Duplicate   key.Binding
+	Move        key.Binding
+	Hide        key.Binding
+	Sort        key.Binding
 	Search      key.Binding
 	EditNote    key.Binding
 	ViewNote    key.Binding
@@ -134,8 +146,8 @@ func (k listKeyMap) ShortHelp() []key.Binding {
 // FullHelp returns keybindings for the expanded help view.
 func (k listKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Toggle, k.AddTask, k.EditTask, k.Search, k.Focus},
-		{k.Archive, k.ArchiveAll, k.ViewArchive, k.Sync},
+		{k.Toggle, k.AddTask, k.EditTask, k.Duplicate, k.Pin, k.Move, k.Search, k.Focus},
+		{k.Archive, k.ArchiveAll, k.ViewArchive, k.Hide, k.Sort, k.Sync},
 		{k.EditNote, k.ViewNote, k.Delete, k.Quit},
 	}
 }
@@ -157,6 +169,26 @@ var listKeys = listKeyMap{
 		key.WithKeys("e"),
 		key.WithHelp("e", "edit task"),
 	),
+	Pin: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "pin/unpin"),
+	),
+	Duplicate: key.NewBinding(
+		key.WithKeys("D"),
+		key.WithHelp("D", "duplicate"),
+	),
+	Move: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "move to other list"),
+	),
+	Hide: key.NewBinding(
+		key.WithKeys("h"),
+		key.WithHelp("h", "hide/show completed"),
+	),
+	Sort: key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "change sort order"),
+	),
 	Search: key.NewBinding(
 		key.WithKeys("/"),
 		key.WithHelp("/", "search"),
@@ -336,6 +368,54 @@ func (i archiveItem) Description() string {
 	return strings.Join(parts, " • ")
 }
 
+// Sort modes for the task list, cycled with o and stored in the config
+const (
+	sortDue     = "due" // the storage order
+	sortTitle   = "title"
+	sortCreated = "created"
+)
+
+var sortModes = []string{sortDue, sortTitle, sortCreated}
+
+// nextSortMode returns the sort mode after mode, wrapping around
+func nextSortMode(mode string) string {
+	for i, m := range sortModes {
+		if m == mode {
+			return sortModes[(i+1)%len(sortModes)]
+		}
+	}
+	return sortModes[0]
+}
+
+// sortTasks orders tasks by mode. Completed tasks stay at the bottom and
+// pinned ones at the top in every mode.
+func sortTasks(tasks []*task.Task, mode string) {
+	var less func(a, b *task.Task) bool
+	switch mode {
+	case sortTitle:
+		less = func(a, b *task.Task) bool {
+			return strings.ToLower(a.Title) < strings.ToLower(b.Title)
+		}
+	case sortCreated:
+		less = func(a, b *task.Task) bool {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+	default:
+		// Already in due date order from storage
+		return
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].Completed != tasks[j].Completed {
+			return !tasks[i].Completed
+		}
+		if !tasks[i].Completed && tasks[i].Pinned != tasks[j].Pinned {
+			return tasks[i].Pinned
+		}
+		return less(tasks[i], tasks[j])
+	})
+}
+
 type issueFilter int
 
 const (
@@ -349,6 +429,8 @@ type Model struct {
 	config        *config.Config
 	storage       *storage.Storage
 	tasks         []*task.Task
+	hideCompleted bool
+	hiddenCount   int
 	issues        []*github.Issue
 	cursor        int
 	view          viewState
@@ -361,6 +443,7 @@ type Model struct {
 	viewingTask   *task.Task
 	spinner       spinner.Model
 	syncing       bool
+	syncProgress  *storage.SyncProgress // counts from the running sync, nil until the first update
 	loadingIssues bool
 	width         int
 	height        int
@@ -487,10 +570,13 @@ func NewModel(cfg *config.Config, store *storage.Storage) Model {
 	lh := help.New()
 	lh.ShowAll = false
 
+	tasks := store.GetTasks()
+	sortTasks(tasks, cfg.SortMode)
+
 	return Model{
 		config:      cfg,
 		storage:     store,
-		tasks:       store.GetTasks(),
+		tasks:       tasks,
 		searchInput: si,
 		addInput:    ai,
 		noteArea:    na,
@@ -695,9 +781,27 @@ func (m *Model) initEditForm(t *task.Task) {
 }
 
 func (m Model) doInitialSync() tea.Cmd {
+	progress := make(chan storage.SyncProgress, syncProgressBuffer)
+	return tea.Batch(
+		func() tea.Msg {
+			_, err := m.storage.SyncWithProgress(progress)
+			return initialSyncDoneMsg{err: err}
+		},
+		waitForSyncProgress(progress),
+	)
+}
+
+// syncProgressBuffer holds progress updates the TUI hasn't picked up yet
+const syncProgressBuffer = 64
+
+// waitForSyncProgress delivers the next progress update from a running sync
+func waitForSyncProgress(ch <-chan storage.SyncProgress) tea.Cmd {
 	return func() tea.Msg {
-		err := m.storage.Sync()
-		return initialSyncDoneMsg{err: err}
+		progress, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return syncProgressMsg{progress: progress, ch: ch}
 	}
 }
 
@@ -852,7 +956,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 
 				// Reload tasks from storage
-				m.tasks = m.storage.GetTasks()
+				m.setTasks(m.storage.GetTasks())
 			}
 
 			// Return to list view and clear form state
@@ -882,21 +986,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 
+	case syncProgressMsg:
+		// Late updates can arrive after the sync finished
+		if m.syncing {
+			m.syncProgress = &msg.progress
+		}
+		cmds = append(cmds, waitForSyncProgress(msg.ch))
+
 	case syncDoneMsg:
 		m.syncing = false
+		m.syncProgress = nil
 		if msg.err != nil {
 			m.statusMsg = fmt.Sprintf("Sync failed: %v", msg.err)
 		} else {
-			m.tasks = m.storage.GetTasks()
+			m.setTasks(m.storage.GetTasks())
 			m.statusMsg = "✓ Sync complete!"
 		}
 
 	case initialSyncDoneMsg:
 		m.syncing = false
+		m.syncProgress = nil
 		if msg.err != nil {
 			m.statusMsg = fmt.Sprintf("Sync failed: %v", msg.err)
 		} else {
-			m.tasks = m.storage.GetTasks()
+			m.setTasks(m.storage.GetTasks())
 			m.statusMsg = "✓ Synced from server"
 		}
 	case issuesLoadedMsg:
@@ -978,7 +1091,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 
 				m.storage.ToggleCompleteWithSync(taskID)
-				m.tasks = m.storage.GetTasks()
+				m.setTasks(m.storage.GetTasks())
 
 				if wasCompleted {
 					// Task was completed, now it's undone - follow it to new position
@@ -1005,7 +1118,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if len(m.tasks) > 0 && m.cursor < len(m.tasks) {
 				t := m.tasks[m.cursor]
 				m.storage.DeleteTaskWithSync(t.ID)
-				m.tasks = m.storage.GetTasks()
+				m.setTasks(m.storage.GetTasks())
 				if m.cursor >= len(m.tasks) && m.cursor > 0 {
 					m.cursor--
 				}
@@ -1051,6 +1164,108 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, m.editForm.Init()
 			}
 
+		case "p":
+			if len(m.tasks) > 0 && m.cursor < len(m.tasks) {
+				t := m.tasks[m.cursor]
+				t.TogglePin()
+
+				var err error
+				if t.ListName == "radicale" && m.storage.IsSyncEnabled() {
+					err = m.storage.UpdateTaskWithSync(t)
+				} else {
+					err = m.storage.UpdateTask(t)
+				}
+
+				m.setTasks(m.storage.GetTasks())
+				m.selectTask(t.ID)
+
+				switch {
+				case err != nil:
+					m.statusMsg = fmt.Sprintf("Failed to update: %v", err)
+				case t.Pinned:
+					m.statusMsg = "⭐ Task pinned"
+				default:
+					m.statusMsg = "Task unpinned"
+				}
+			}
+
+		case "D":
+			if len(m.tasks) > 0 && m.cursor < len(m.tasks) {
+				dup := m.tasks[m.cursor].Duplicate()
+				err := m.storage.AddTaskWithSync(dup)
+
+				m.setTasks(m.storage.GetTasks())
+				m.selectTask(dup.ID)
+
+				if err != nil {
+					m.statusMsg = fmt.Sprintf("Failed to duplicate: %v", err)
+				} else {
+					m.statusMsg = fmt.Sprintf("Duplicated: %s", dup.Title)
+				}
+			}
+
+		case "m":
+			// Move task between local and radicale
+			if len(m.tasks) > 0 && m.cursor < len(m.tasks) {
+				t := m.tasks[m.cursor]
+				target := "radicale"
+				if t.ListName == "radicale" {
+					target = "local"
+				}
+
+				if err := m.storage.MoveTask(t.ID, target); err != nil {
+					m.statusMsg = fmt.Sprintf("Failed to move: %v", err)
+				} else {
+					m.statusMsg = fmt.Sprintf("✓ Moved to %s: %s", target, t.Title)
+				}
+
+				m.setTasks(m.storage.GetTasks())
+				m.selectTask(t.ID)
+			}
+
+		case "h":
+			// Hide/show completed tasks, keeping the selection if it stays visible
+			var selectedID string
+			if m.cursor < len(m.tasks) {
+				selectedID = m.tasks[m.cursor].ID
+			}
+
+			m.hideCompleted = !m.hideCompleted
+			if m.searchInput.Value() != "" {
+				m.setTasks(m.storage.Search(m.searchInput.Value()))
+			} else {
+				m.setTasks(m.storage.GetTasks())
+			}
+
+			m.cursor = 0
+			m.selectTask(selectedID)
+			if m.hideCompleted {
+				m.statusMsg = "Hiding completed tasks"
+			} else {
+				m.statusMsg = "Showing completed tasks"
+			}
+
+		case "o":
+			// Cycle sort order and remember it
+			var selectedID string
+			if m.cursor < len(m.tasks) {
+				selectedID = m.tasks[m.cursor].ID
+			}
+
+			m.config.SortMode = nextSortMode(m.config.SortMode)
+			if m.searchInput.Value() != "" {
+				m.setTasks(m.storage.Search(m.searchInput.Value()))
+			} else {
+				m.setTasks(m.storage.GetTasks())
+			}
+			m.selectTask(selectedID)
+
+			if err := config.Save(m.config); err != nil {
+				m.statusMsg = fmt.Sprintf("Sorted by %s (failed to save: %v)", m.config.SortMode, err)
+			} else {
+				m.statusMsg = "Sorted by " + m.config.SortMode
+			}
+
 		case "s":
 			// Manual sync
 			if m.storage.IsSyncEnabled() && !m.syncing {
@@ -1065,7 +1280,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				t := m.tasks[m.cursor]
 				if t.Completed {
 					if err := m.storage.ArchiveTask(t.ID); err == nil {
-						m.tasks = m.storage.GetTasks()
+						m.setTasks(m.storage.GetTasks())
 						if m.cursor >= len(m.tasks) && m.cursor > 0 {
 							m.cursor--
 						}
@@ -1083,7 +1298,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if !m.showArchive {
 				count, err := m.storage.ArchiveAllCompletedTasks()
 				if err == nil {
-					m.tasks = m.storage.GetTasks()
+					m.setTasks(m.storage.GetTasks())
 					m.cursor = 0
 					m.statusMsg = fmt.Sprintf("✓ Archived %d completed task(s)", count)
 				} else {
@@ -1099,7 +1314,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.view = viewArchive
 				m.statusMsg = "Viewing archive"
 			} else {
-				m.tasks = m.storage.GetTasks()
+				m.setTasks(m.storage.GetTasks())
 				m.statusMsg = "Viewing active tasks"
 			}
 			m.cursor = 0
@@ -1120,7 +1335,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				m.statusMsg = ""
 			} else {
-				m.tasks = m.storage.GetTasks()
+				m.setTasks(m.storage.GetTasks())
 				m.statusMsg = "Viewing active tasks"
 			}
 			m.cursor = 0
@@ -1136,13 +1351,48 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-func (m Model) doSync() tea.Cmd {
-	return func() tea.Msg {
-		err := m.storage.Sync()
-		return syncDoneMsg{err: err}
+// setTasks sets the listed tasks in the current sort order, leaving out
+// completed ones while they're hidden
+func (m *Model) setTasks(tasks []*task.Task) {
+	sortTasks(tasks, m.config.SortMode)
+
+	m.hiddenCount = 0
+	if !m.hideCompleted {
+		m.tasks = tasks
+		return
+	}
+
+	m.tasks = make([]*task.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if t.Completed {
+			m.hiddenCount++
+			continue
+		}
+		m.tasks = append(m.tasks, t)
 	}
 }
 
+// selectTask moves the cursor to the task with the given ID, if it's listed
+func (m *Model) selectTask(id string) {
+	for i, t := range m.tasks {
+		if t.ID == id {
+			m.cursor = i
+			return
+		}
+	}
+}
+
+func (m Model) doSync() tea.Cmd {
+	progress := make(chan storage.SyncProgress, syncProgressBuffer)
+	return tea.Batch(
+		func() tea.Msg {
+			_, err := m.storage.SyncWithProgress(progress)
+			return syncDoneMsg{err: err}
+		},
+		waitForSyncProgress(progress),
+	)
+}
+
 func (m Model) handleSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	key := msg.String()
 
@@ -1151,7 +1401,7 @@ func (m Model) handleSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.view = viewList
 		m.searchInput.SetValue("")
 		m.searchInput.Blur()
-		m.tasks = m.storage.GetTasks()
+		m.setTasks(m.storage.GetTasks())
 		return m, nil
 
 	case "enter":
@@ -1165,7 +1415,7 @@ func (m Model) handleSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.searchInput, cmd = m.searchInput.Update(msg)
 
 	// Live search
-	m.tasks = m.storage.Search(m.searchInput.Value())
+	m.setTasks(m.storage.Search(m.searchInput.Value()))
 	m.cursor = 0
 
 	return m, cmd
@@ -1192,7 +1442,7 @@ func (m Model) handleAddInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Parse the input for title, tags, and due date
 		newTask := m.parseTaskInput(input)
 		m.storage.AddTaskWithSync(newTask)
-		m.tasks = m.storage.GetTasks()
+		m.setTasks(m.storage.GetTasks())
 		m.statusMsg = fmt.Sprintf("Added: %s", newTask.Title)
 
 		m.view = viewList
@@ -1218,7 +1468,7 @@ func (m Model) handleNoteInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			if m.editingTask.ListName == "radicale" {
 				m.storage.PushTask(m.editingTask)
 			}
-			m.tasks = m.storage.GetTasks()
+			m.setTasks(m.storage.GetTasks())
 			m.statusMsg = "Note saved"
 		}
 		m.view = viewList
@@ -1234,7 +1484,7 @@ func (m Model) handleNoteInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			if m.editingTask.ListName == "radicale" {
 				m.storage.PushTask(m.editingTask)
 			}
-			m.tasks = m.storage.GetTasks()
+			m.setTasks(m.storage.GetTasks())
 			m.statusMsg = "Note saved"
 		}
 		return m, nil
@@ -1293,7 +1543,7 @@ func (m Model) handleFocusMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 
 			m.storage.ToggleCompleteWithSync(t.ID)
-			m.tasks = m.storage.GetTasks()
+			m.setTasks(m.storage.GetTasks())
 			m.statusMsg = "✓ Task completed!"
 			m.syncing = false
 
@@ -1317,7 +1567,7 @@ func (m Model) handleIssueMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, issueKeys.ViewIssues):
 		m.showIssues = false
 		m.view = viewList
-		m.tasks = m.storage.GetTasks()
+		m.setTasks(m.storage.GetTasks())
 		m.statusMsg = "Viewing active tasks"
 		return m, nil
 	case key.Matches(msg, issueKeys.Filter):
@@ -1356,7 +1606,7 @@ func (m Model) handleArchiveMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Exit archive view
 		m.showArchive = false
 		m.view = viewList
-		m.tasks = m.storage.GetTasks()
+		m.setTasks(m.storage.GetTasks())
 		m.statusMsg = "Viewing active tasks"
 		return m, nil
 
@@ -1389,8 +1639,9 @@ func (m Model) parseTaskInput(input string) *task.Task {
 	for _, part := range parts {
 		if strings.HasPrefix(part, "+") {
 			suffix := part[1:]
-			// Check if it's a date pattern
-			if _, err := task.ParseDueDate(suffix); err == nil {
+			// Check if it's a date pattern. Tags already in use win, so
+			// tags like +mon or +sat aren't read as weekdays.
+			if _, err := task.ParseDueDate(suffix); err == nil && !m.hasTag(suffix) {
 				dueStr = suffix
 			} else {
 				// It's a tag
@@ -1417,6 +1668,19 @@ func (m Model) parseTaskInput(input string) *task.Task {
 	return newTask
 }
 
+// hasTag reports whether a task already uses tag
+func (m Model) hasTag(tag string) bool {
+	tag = strings.ToLower(tag)
+	for _, t := range m.storage.GetTasks() {
+		for _, existing := range t.Tags {
+			if existing == tag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (m Model) View() string {
 	if m.quitting {
 		return ""
@@ -1467,6 +1731,9 @@ func (m Model) View() string {
 
 	// Title
 	title := "📋 Tasks"
+	if m.config.SortMode != "" && m.config.SortMode != sortDue {
+		title += " · by " + m.config.SortMode
+	}
 	if m.showArchive {
 		title = "📦 Archive"
 	}
@@ -1480,7 +1747,7 @@ func (m Model) View() string {
 	// Add task form (if active)
 	if m.view == viewAddTask {
 		b.WriteString(inputStyle.Render("➕ "+m.addInput.View()) + "\n")
-		b.WriteString(helpStyle.Render("  +tag for tags, +1d/+1w/tomorrow for due") + "\n\n")
+		b.WriteString(helpStyle.Render("  +tag for tags, +1d/+1w/+tomorrow/+fri/+nextfri for due (existing tags stay tags)") + "\n\n")
 	}
 
 	// Edit task form (if active)
@@ -1521,7 +1788,11 @@ func (m Model) View() string {
 
 	// Syncing spinner
 	if m.syncing {
-		b.WriteString(m.spinner.View() + " Syncing...\n")
+		if p := m.syncProgress; p != nil {
+			b.WriteString(m.spinner.View() + fmt.Sprintf(" Syncing... pulled %d, pushed %d\n", p.Pulled, p.Pushed))
+		} else {
+			b.WriteString(m.spinner.View() + " Syncing...\n")
+		}
 	}
 
 	// Loading issues spinner
@@ -1534,6 +1805,10 @@ func (m Model) View() string {
 		b.WriteString(statusStyle.Render(m.statusMsg) + "\n")
 	}
 
+	if m.hideCompleted && m.hiddenCount > 0 {
+		b.WriteString(helpStyle.UnsetMarginTop().Render(fmt.Sprintf("%d completed hidden (h to show)", m.hiddenCount)) + "\n")
+	}
+
 	// Help
 	if m.showArchive {
 		b.WriteString(m.listHelp.View(archiveKeys))
@@ -1562,6 +1837,12 @@ func (m Model) renderTask(t *task.Task, selected bool) string {
 		titleRendered = taskStyle.Render(title)
 	}
 
+	// Pinned marker
+	pin := ""
+	if t.Pinned {
+		pin = " ⭐"
+	}
+
 	// Note indicator
 	noteIndicator := ""
 	if t.HasNote() {
@@ -1590,7 +1871,7 @@ func (m Model) renderTask(t *task.Task, selected bool) string {
 	}
 
 	// Combine
-	line := fmt.Sprintf("  %s %s%s%s%s", checkbox, titleRendered, noteIndicator, dueStr, tags)
+	line := fmt.Sprintf("  %s %s%s%s%s%s", checkbox, titleRendered, pin, noteIndicator, dueStr, tags)
 
 	if selected {
 		// Highlight the whole line
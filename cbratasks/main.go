@@ -5,14 +5,21 @@ import (
 	"os"
 	"strings"
 
+	"cbratasks/internal/caldav"
 	"cbratasks/internal/config"
 	"cbratasks/internal/storage"
 	"cbratasks/internal/task"
 	"cbratasks/internal/tui"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 )
 
+// cliTagStyle matches the tag pills in the TUI
+var cliTagStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#282A36")).
+	Padding(0, 1)
+
 func main() {
 	rootCmd := &cobra.Command{
 		Use:   "cbratasks",
@@ -44,7 +51,7 @@ Examples:
 		},
 	}
 
-	addCmd.Flags().StringVarP(&dueFlag, "due", "d", "", "Due date (+1d, +1w, tomorrow, nextweek, DD-MM-YYYY)")
+	addCmd.Flags().StringVarP(&dueFlag, "due", "d", "", "Due date (+1d, +1w, tomorrow, nextweek, friday, next friday, DD-MM-YYYY)")
 	addCmd.Flags().StringSliceVarP(&tagsFlag, "tag", "T", nil, "Tags (can be specified multiple times)")
 	addCmd.Flags().StringVarP(&listFlag, "list", "l", "", "Task list (local or radicale)")
 	addCmd.Flags().StringVarP(&noteFlag, "note", "n", "", "Attach a note to the task")
@@ -67,12 +74,26 @@ Output format (one task per line):
 		RunE: runToday,
 	}
 
+	var searchFlag string
+	var archiveTagFlag string
+
 	archiveCmd := &cobra.Command{
 		Use:   "archive",
 		Short: "Show archived tasks",
-		RunE:  runArchive,
+		Long: `Show archived tasks, optionally filtered.
+
+Examples:
+  cbratasks archive
+  cbratasks archive --search "tax return"
+  cbratasks archive --tag work`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runArchive(searchFlag, archiveTagFlag)
+		},
 	}
 
+	archiveCmd.Flags().StringVarP(&searchFlag, "search", "s", "", "Fuzzy search archived task titles")
+	archiveCmd.Flags().StringVarP(&archiveTagFlag, "tag", "T", "", "Only show archived tasks with this tag")
+
 	syncCmd := &cobra.Command{
 		Use:   "sync",
 		Short: "Sync tasks with CalDAV server (Radicale)",
@@ -83,11 +104,32 @@ A 'cbratasks' collection will be created automatically if it doesn't exist.`,
 		RunE: runSync,
 	}
 
+	var outputFlag string
+	var includeArchivedFlag bool
+
+	exportCmd := &cobra.Command{
+		Use:   "export-ics",
+		Short: "Export tasks to an iCalendar (.ics) file",
+		Long: `Write all active tasks as VTODOs to an iCalendar file that other
+calendar and task apps can import.
+
+Examples:
+  cbratasks export-ics
+  cbratasks export-ics -o ~/backup/tasks.ics --archived`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportICS(outputFlag, includeArchivedFlag)
+		},
+	}
+
+	exportCmd.Flags().StringVarP(&outputFlag, "output", "o", "cbratasks.ics", "File to write")
+	exportCmd.Flags().BoolVarP(&includeArchivedFlag, "archived", "a", false, "Include archived tasks")
+
 	rootCmd.AddCommand(addCmd)
 	rootCmd.AddCommand(listCmd)
 	rootCmd.AddCommand(todayCmd)
 	rootCmd.AddCommand(archiveCmd)
 	rootCmd.AddCommand(syncCmd)
+	rootCmd.AddCommand(exportCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
@@ -174,7 +216,8 @@ func runAdd(args []string, dueFlag string, tagsFlag []string, listFlag string, n
 
 func runList(cmd *cobra.Command, args []string) error {
 	// Ensure config exists
-	if _, err := config.Load(); err != nil {
+	cfg, err := config.Load()
+	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
@@ -184,6 +227,7 @@ func runList(cmd *cobra.Command, args []string) error {
 	}
 
 	tasks := store.GetTasks()
+	color := useColor()
 
 	if len(tasks) == 0 {
 		fmt.Println("No tasks. Add one with: cbratasks add \"task name\"")
@@ -210,7 +254,11 @@ func runList(cmd *cobra.Command, args []string) error {
 		}
 
 		if len(t.Tags) > 0 {
-			line += fmt.Sprintf(" (%s)", strings.Join(t.Tags, ", "))
+			if color {
+				line += " " + colorTags(cfg, t.Tags)
+			} else {
+				line += fmt.Sprintf(" (%s)", strings.Join(t.Tags, ", "))
+			}
 		}
 
 		if t.IsOverdue() {
@@ -244,12 +292,22 @@ func runToday(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Tags are only colored for a terminal, so piped output stays plain
+	var cfg *config.Config
+	if useColor() {
+		cfg, _ = config.Load()
+	}
+
 	// Simple output format for scripts/integrations
 	for _, t := range tasks {
 		line := fmt.Sprintf("- %s", t.Title)
 
 		if len(t.Tags) > 0 {
-			line += fmt.Sprintf(" [%s]", strings.Join(t.Tags, ", "))
+			if cfg != nil {
+				line += " " + colorTags(cfg, t.Tags)
+			} else {
+				line += fmt.Sprintf(" [%s]", strings.Join(t.Tags, ", "))
+			}
 		}
 
 		line += fmt.Sprintf(" (%s)", t.ID)
@@ -260,16 +318,46 @@ func runToday(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runArchive(cmd *cobra.Command, args []string) error {
+// useColor reports whether CLI output may be colored: stdout is a terminal
+// and NO_COLOR is not set
+func useColor() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// colorTags renders tags as colored pills using the configured tag colors
+func colorTags(cfg *config.Config, tags []string) string {
+	parts := make([]string, len(tags))
+	for i, tag := range tags {
+		parts[i] = cliTagStyle.Background(lipgloss.Color(cfg.GetTagColor(tag))).Render(tag)
+	}
+	return strings.Join(parts, " ")
+}
+
+func runArchive(searchFlag string, tagFlag string) error {
 	store, err := storage.New()
 	if err != nil {
 		return fmt.Errorf("failed to initialize storage: %w", err)
 	}
 
-	archived := store.GetArchivedTasks()
+	filtered := searchFlag != "" || tagFlag != ""
+
+	var archived []*task.Task
+	if filtered {
+		archived = store.SearchArchived(searchFlag, tagFlag)
+	} else {
+		archived = store.GetArchivedTasks()
+	}
 
 	if len(archived) == 0 {
-		fmt.Println("No archived tasks.")
+		if filtered {
+			fmt.Println("No matching archived tasks.")
+		} else {
+			fmt.Println("No archived tasks.")
+		}
 		return nil
 	}
 
@@ -292,6 +380,31 @@ func runArchive(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runExportICS(outputFlag string, includeArchived bool) error {
+	store, err := storage.New()
+	if err != nil {
+		return fmt.Errorf("failed to initialize storage: %w", err)
+	}
+
+	tasks := store.GetTasks()
+	active := len(tasks)
+	if includeArchived {
+		tasks = append(tasks, store.GetArchivedTasks()...)
+	}
+
+	if err := os.WriteFile(outputFlag, []byte(caldav.ToICS(tasks)), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputFlag, err)
+	}
+
+	if includeArchived {
+		fmt.Printf("✓ Exported %d tasks (%d archived) to %s\n", len(tasks), len(tasks)-active, outputFlag)
+	} else {
+		fmt.Printf("✓ Exported %d tasks to %s\n", len(tasks), outputFlag)
+	}
+
+	return nil
+}
+
 func runSync(cmd *cobra.Command, args []string) error {
 	cfg, err := config.Load()
 	if err != nil {
@@ -318,7 +431,8 @@ func runSync(cmd *cobra.Command, args []string) error {
 	fmt.Println("🔄 Syncing with CalDAV server...")
 	fmt.Printf("   Server: %s\n", cfg.Sync.URL)
 
-	if err := store.Sync(); err != nil {
+	counts, err := store.SyncWithProgress(nil)
+	if err != nil {
 		return fmt.Errorf("sync failed: %w", err)
 	}
 
@@ -331,7 +445,7 @@ func runSync(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Printf("✓ Sync complete! (%d tasks from server)\n", radicaleCount)
+	fmt.Printf("✓ Sync complete! (%d tasks from server, %d pushed)\n", radicaleCount, counts.Pushed)
 
 	return nil
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -42,11 +43,34 @@ var (
 			Foreground(lipgloss.Color("82")).
 			Render(" ✓")
 
+	queuedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("243")).
+			Render(" ⏳ queued")
+
+	runningStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("205")).
+			Render(" ⬇ downloading")
+
+	failedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196")).
+			Render(" ✗ failed")
+
 	borderStyle = lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
 			BorderForeground(lipgloss.Color("62")).
 			Padding(1, 2)
 
+	// newCountStyle highlights videos published since the last run
+	newCountStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("214"))
+
+	newMarker = newCountStyle.Render(" • new")
+
+	confirmStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("214"))
+
 	searchStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("240")).
 			Border(lipgloss.RoundedBorder()).
@@ -65,8 +89,12 @@ type Config struct {
 	MaxVideos   int      `toml:"max_videos"`   // Max videos per channel to load
 	DownloadDir string   `toml:"download_dir"` // Directory to download videos to
 	Colors      []string `toml:"colors"`       // Channel colors (10 colors, reused if needed)
+
+	MaxConcurrentDownloads int `toml:"max_concurrent_downloads"` // Downloads running at once from the queue
 }
 
+const defaultMaxConcurrentDownloads = 1
+
 type Video struct {
 	ID        string
 	Title     string
@@ -82,6 +110,34 @@ type videoWithStatus struct {
 	Video        Video
 	Downloaded   bool
 	ChannelColor string // Color for the channel
+	Queued       bool   // Video is in the download queue, see QueueState
+	QueueState   downloadState
+	New          bool // Published since the previous run
+}
+
+type downloadState int
+
+const (
+	downloadQueued downloadState = iota
+	downloadRunning
+	downloadDone
+	downloadFailed
+)
+
+// queuedDownload is one entry of the download queue
+type queuedDownload struct {
+	video Video
+	state downloadState
+	err   error
+	itag  int // Format to download, 0 picks one automatically
+}
+
+// formatPicker lists the formats of a video before downloading it. Row 0 is
+// automatic selection, row i is formats[i-1].
+type formatPicker struct {
+	video    Video
+	formats  []youtube.Format
+	selected int
 }
 
 func (v videoWithStatus) FilterValue() string { return v.Video.FilterValue() }
@@ -95,12 +151,26 @@ func (d videoDelegate) Render(w io.Writer, m list.Model, index int, item list.It
 	var v Video
 	var isDownloaded bool
 	var channelColor string
+	statusMarker := ""
 
 	// Handle both Video and videoWithStatus types
 	if vws, ok := item.(videoWithStatus); ok {
 		v = vws.Video
 		isDownloaded = vws.Downloaded
 		channelColor = vws.ChannelColor // Get the channel color
+		if vws.New {
+			statusMarker = newMarker
+		}
+		if vws.Queued {
+			switch vws.QueueState {
+			case downloadQueued:
+				statusMarker = queuedStyle
+			case downloadRunning:
+				statusMarker = runningStyle
+			case downloadFailed:
+				statusMarker = failedStyle
+			}
+		}
 	} else if vid, ok := item.(Video); ok {
 		v = vid
 		isDownloaded = false
@@ -122,7 +192,7 @@ func (d videoDelegate) Render(w io.Writer, m list.Model, index int, item list.It
 		channelColorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(channelColor))
 	}
 
-	titleText := v.Title + downloadedMarker
+	titleText := v.Title + downloadedMarker + statusMarker
 	channelText := v.Channel
 	timeText := "• " + v.Published.Format("2006-01-02 15:04")
 
@@ -144,8 +214,13 @@ type model struct {
 	config               Config
 	configPath           string
 	quitting             bool
-	downloading          bool
-	downloadURL          string
+	downloads            []queuedDownload // Download queue, in the order videos were added
+	confirmQuit          bool             // Quitting with running downloads needs a second press
+	ctx                  context.Context  // Cancelled to stop running downloads
+	cancel               context.CancelFunc
+	statusMessage        string
+	pendingDelete        *Video    // Downloaded video waiting for delete confirmation
+	lastSeen             time.Time // Newest video of the previous run; later ones are new
 	spinner              spinner.Model
 	searching            bool
 	searchQuery          string
@@ -155,6 +230,8 @@ type model struct {
 	channelInput         string
 	selectedChannelIndex int
 	channelMessage       string
+	formatPicker         *formatPicker // Open format picker, nil when closed
+	formatItag           int           // Format picked this session, 0 = automatic
 }
 
 type videosLoadedMsg struct {
@@ -165,11 +242,19 @@ type videosLoadedMsg struct {
 // Removed downloadProgressMsg - using spinner instead
 
 type downloadCompleteMsg struct {
+	videoID  string // Queue entry this download belongs to
 	err      error
 	message  string
 	useYtDlp bool // Flag to indicate we should use yt-dlp fallback
 }
 
+// formatsLoadedMsg carries the formats of a video for the format picker
+type formatsLoadedMsg struct {
+	video   Video
+	formats []youtube.Format
+	err     error
+}
+
 func (m model) Init() tea.Cmd {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
@@ -206,6 +291,31 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return handleChannelManagerKey(m, msg)
 		}
 
+		if m.formatPicker != nil {
+			return handleFormatPickerKey(m, msg)
+		}
+
+		// Delete confirmation: only y deletes, any other key cancels
+		if m.pendingDelete != nil {
+			v := *m.pendingDelete
+			m.pendingDelete = nil
+			if msg.String() != "y" {
+				m.statusMessage = ""
+				return m, nil
+			}
+			path := getDownloadedVideoPath(m.config.DownloadDir, v)
+			if path == "" {
+				return m, nil
+			}
+			if err := os.Remove(path); err != nil {
+				m.statusMessage = fmt.Sprintf("Delete failed: %v", err)
+				return m, nil
+			}
+			m.statusMessage = fmt.Sprintf("Deleted %s", v.Title)
+			// Reload videos to update UI
+			return m, loadVideos(m.config)
+		}
+
 		// Handle search mode
 		if m.searching {
 			switch msg.String() {
@@ -215,9 +325,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Reset filter
 				items := make([]list.Item, len(m.videos))
 				for i, v := range m.videos {
-					downloaded := isVideoDownloaded(m.config.DownloadDir, v)
-					channelColor := m.channelColors[v.Channel]
-					items[i] = videoWithStatus{Video: v, Downloaded: downloaded, ChannelColor: channelColor}
+					items[i] = m.newItem(v)
 				}
 				m.list.SetItems(items)
 				return m, nil
@@ -241,9 +349,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					titleMatch := strings.Contains(strings.ToLower(v.Title), query)
 					channelMatch := strings.Contains(strings.ToLower(v.Channel), query)
 					if titleMatch || channelMatch {
-						downloaded := isVideoDownloaded(m.config.DownloadDir, v)
-						channelColor := m.channelColors[v.Channel]
-						filtered = append(filtered, videoWithStatus{Video: v, Downloaded: downloaded, ChannelColor: channelColor})
+						filtered = append(filtered, m.newItem(v))
 					}
 				}
 				m.list.SetItems(filtered)
@@ -251,15 +357,17 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Show all videos if search is empty
 				items := make([]list.Item, len(m.videos))
 				for i, v := range m.videos {
-					downloaded := isVideoDownloaded(m.config.DownloadDir, v)
-					channelColor := m.channelColors[v.Channel]
-					items[i] = videoWithStatus{Video: v, Downloaded: downloaded, ChannelColor: channelColor}
+					items[i] = m.newItem(v)
 				}
 				m.list.SetItems(items)
 			}
 			return m, nil
 		}
 
+		if msg.String() != "q" && msg.String() != "ctrl+c" {
+			m.confirmQuit = false
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			if m.searching {
@@ -267,6 +375,20 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.searchQuery = ""
 				return m, nil
 			}
+			if running := m.countDownloads(downloadRunning); running > 0 {
+				if !m.confirmQuit {
+					m.confirmQuit = true
+					m.statusMessage = fmt.Sprintf("%d downloads running - press q again to cancel them and quit", running)
+					return m, nil
+				}
+				if m.ctx.Err() == nil {
+					// Quit once the cancelled downloads have cleaned up
+					m.cancel()
+					m.statusMessage = "Cancelling downloads..."
+					return m, nil
+				}
+				// Pressed again while cancelling, stop waiting
+			}
 			m.quitting = true
 			return m, tea.Quit
 		case "/":
@@ -283,7 +405,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.loading = true
 			return m, loadVideos(m.config)
 		case "enter":
-			if len(m.videos) > 0 && !m.downloading {
+			if len(m.videos) > 0 && m.ctx.Err() == nil {
 				selectedItem := m.list.SelectedItem()
 				var v Video
 				if vws, ok := selectedItem.(videoWithStatus); ok {
@@ -293,16 +415,26 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				} else {
 					return m, nil
 				}
-				m.downloading = true
-				m.downloadURL = v.URL
-				return m, tea.Batch(
-					downloadVideo(m.config.DownloadDir, v.URL),
-					m.spinner.Tick,
-				)
+				return m, m.enqueueDownload(v)
+			}
+		case "f":
+			// Pick the format before downloading
+			if len(m.videos) > 0 && m.ctx.Err() == nil {
+				selectedItem := m.list.SelectedItem()
+				var v Video
+				if vws, ok := selectedItem.(videoWithStatus); ok {
+					v = vws.Video
+				} else if vid, ok := selectedItem.(Video); ok {
+					v = vid
+				} else {
+					return m, nil
+				}
+				m.statusMessage = fmt.Sprintf("Loading formats of %s...", v.Title)
+				return m, loadFormats(m.ctx, v)
 			}
 		case "d":
 			// Delete downloaded video
-			if len(m.videos) > 0 && !m.downloading {
+			if len(m.videos) > 0 {
 				selectedItem := m.list.SelectedItem()
 				var v Video
 				if vws, ok := selectedItem.(videoWithStatus); ok {
@@ -318,13 +450,31 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				} else {
 					return m, nil
 				}
-				// Delete the file and reload
-				path := getDownloadedVideoPath(m.config.DownloadDir, v)
-				if path != "" {
-					os.Remove(path)
-					// Reload videos to update UI
-					return m, loadVideos(m.config)
+				if d := m.findDownload(v.ID); d != nil && d.state == downloadRunning {
+					return m, nil // Still being written
+				}
+				// Ask first, deleting is right next to downloading
+				m.pendingDelete = &v
+				return m, nil
+			}
+		case "y":
+			// Copy the video URL
+			if len(m.videos) > 0 {
+				selectedItem := m.list.SelectedItem()
+				var v Video
+				if vws, ok := selectedItem.(videoWithStatus); ok {
+					v = vws.Video
+				} else if vid, ok := selectedItem.(Video); ok {
+					v = vid
+				} else {
+					return m, nil
+				}
+				if err := copyToClipboard(v.URL); err != nil {
+					m.statusMessage = fmt.Sprintf("Copy failed: %v", err)
+				} else {
+					m.statusMessage = "Copied!"
 				}
+				return m, nil
 			}
 		case "o":
 			// Open video (file if downloaded, URL if not)
@@ -368,22 +518,47 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		items := make([]list.Item, len(m.videos))
 		for i, v := range m.videos {
 			// Check if video is downloaded and wrap it
-			downloaded := isVideoDownloaded(m.config.DownloadDir, v)
-			channelColor := m.channelColors[v.Channel]
-			items[i] = videoWithStatus{Video: v, Downloaded: downloaded, ChannelColor: channelColor}
+			items[i] = m.newItem(v)
 		}
 		m.list.SetItems(items)
 		// Make sure the list is visible
 		if len(items) > 0 {
 			m.list.Select(0)
 		}
+
+		// Remember what was shown for the next run. m.lastSeen stays as is
+		// so videos keep their badge for this session.
+		if len(m.videos) > 0 {
+			newest := m.videos[0].Published // sorted newest first
+			if newest.After(m.lastSeen) {
+				if err := saveLastSeen(m.configPath, newest); err != nil {
+					m.statusMessage = fmt.Sprintf("Could not save last seen marker: %v", err)
+				}
+			}
+		}
+		return m, nil
+
+	case formatsLoadedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Could not load formats: %v", msg.err)
+			return m, nil
+		}
+		m.statusMessage = ""
+		picker := &formatPicker{video: msg.video, formats: msg.formats}
+		// Start on the format picked last, if this video has it
+		for i, f := range msg.formats {
+			if f.ItagNo == m.formatItag {
+				picker.selected = i + 1
+			}
+		}
+		m.formatPicker = picker
 		return m, nil
 
 	case tea.WindowSizeMsg:
 		// Account for border: 2 chars padding on each side = 4, plus 2 for border itself = 6 total width
 		m.list.SetWidth(msg.Width - 6)
-		// Reserve space: border top/bottom (2) + header (2) + spacing (1) + footer (2) + spacing (1) = 8 base
-		height := msg.Height - 8
+		// Reserve space: border top/bottom (2) + header (2) + spacing (1) + status (1) + footer (2) + spacing (1) = 9 base
+		height := msg.Height - 9
 		if m.searching {
 			height -= 2 // Extra space for search bar
 		}
@@ -392,38 +567,42 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case downloadCompleteMsg:
-		if msg.useYtDlp {
-			// Fallback to yt-dlp
-			selectedItem := m.list.SelectedItem()
-			var v Video
-			if vws, ok := selectedItem.(videoWithStatus); ok {
-				v = vws.Video
-			} else if vid, ok := selectedItem.(Video); ok {
-				v = vid
-			} else {
-				return m, nil
-			}
-			// Keep downloading state, but switch to yt-dlp
-			m.err = nil // Clear any previous errors
+		d := m.findDownload(msg.videoID)
+		if d == nil {
+			return m, nil
+		}
+		if msg.useYtDlp && m.ctx.Err() == nil {
+			// Fallback to yt-dlp, the entry stays running
 			return m, tea.Batch(
-				downloadVideoWithYtDlp(m.config.DownloadDir, v.URL),
+				withVideoID(d.video.ID, downloadVideoWithYtDlp(m.ctx, m.config.DownloadDir, d.video.URL)),
 				m.spinner.Tick,
 			)
 		}
-		m.downloading = false
-		if msg.err != nil {
-			m.err = msg.err
-		} else if msg.message != "" {
-			// Success message - clear any previous errors
-			m.err = nil
-			// Reload videos to update download status
-			return m, loadVideos(m.config)
+		err := msg.err
+		if msg.useYtDlp && err == nil {
+			// Cancelled before the yt-dlp fallback could run, nothing was
+			// downloaded
+			err = fmt.Errorf("download cancelled: %w", m.ctx.Err())
 		}
-		return m, nil
+		if err != nil {
+			d.state = downloadFailed
+			d.err = err
+			m.statusMessage = fmt.Sprintf("Download failed: %s: %v", d.video.Title, err)
+		} else {
+			d.state = downloadDone
+			m.statusMessage = fmt.Sprintf("Downloaded %s", d.video.Title)
+		}
+		m.updateItem(d.video.ID)
+
+		if m.ctx.Err() != nil && m.countDownloads(downloadRunning) == 0 {
+			m.quitting = true
+			return m, tea.Quit
+		}
+		return m, tea.Batch(m.startDownloads(), m.spinner.Tick)
 
 	case spinner.TickMsg:
 		var cmd tea.Cmd
-		if m.downloading || m.loading {
+		if m.countDownloads(downloadRunning) > 0 || m.loading {
 			m.spinner, cmd = m.spinner.Update(msg)
 			if cmd != nil {
 				return m, cmd
@@ -438,6 +617,142 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// newItem wraps v with its download and queue status for the list
+func (m model) newItem(v Video) videoWithStatus {
+	item := videoWithStatus{
+		Video:        v,
+		Downloaded:   isVideoDownloaded(m.config.DownloadDir, v),
+		ChannelColor: m.channelColors[v.Channel],
+		New:          m.isNew(v),
+	}
+	if d := m.findDownload(v.ID); d != nil {
+		item.Queued = true
+		item.QueueState = d.state
+	}
+	return item
+}
+
+// isNew reports whether v was published after the newest video of the
+// previous run. On the very first run nothing is new.
+func (m model) isNew(v Video) bool {
+	return !m.lastSeen.IsZero() && v.Published.After(m.lastSeen)
+}
+
+// updateItem refreshes the list entry of the video with the given ID
+func (m *model) updateItem(videoID string) {
+	for i, item := range m.list.Items() {
+		if vws, ok := item.(videoWithStatus); ok && vws.Video.ID == videoID {
+			m.list.SetItem(i, m.newItem(vws.Video))
+		}
+	}
+}
+
+func (m model) findDownload(videoID string) *queuedDownload {
+	for i := range m.downloads {
+		if m.downloads[i].video.ID == videoID {
+			return &m.downloads[i]
+		}
+	}
+	return nil
+}
+
+func (m model) countDownloads(state downloadState) int {
+	count := 0
+	for _, d := range m.downloads {
+		if d.state == state {
+			count++
+		}
+	}
+	return count
+}
+
+// enqueueDownload adds v to the download queue and starts it if a slot is free.
+// Finished or failed downloads are queued again.
+func (m *model) enqueueDownload(v Video) tea.Cmd {
+	if d := m.findDownload(v.ID); d != nil {
+		if d.state == downloadQueued || d.state == downloadRunning {
+			m.statusMessage = "Already in the download queue"
+			return nil
+		}
+		d.state = downloadQueued
+		d.err = nil
+		d.itag = m.formatItag
+	} else {
+		m.downloads = append(m.downloads, queuedDownload{video: v, itag: m.formatItag})
+	}
+	m.statusMessage = fmt.Sprintf("Queued %s", v.Title)
+	m.updateItem(v.ID)
+	return tea.Batch(m.startDownloads(), m.spinner.Tick)
+}
+
+// startDownloads starts queued downloads until the concurrency limit is reached
+func (m *model) startDownloads() tea.Cmd {
+	if m.ctx.Err() != nil {
+		return nil
+	}
+
+	limit := m.config.MaxConcurrentDownloads
+	if limit <= 0 {
+		limit = defaultMaxConcurrentDownloads
+	}
+
+	var cmds []tea.Cmd
+	running := m.countDownloads(downloadRunning)
+	for i := range m.downloads {
+		if running >= limit {
+			break
+		}
+		if m.downloads[i].state != downloadQueued {
+			continue
+		}
+		m.downloads[i].state = downloadRunning
+		running++
+
+		v := m.downloads[i].video
+		cmds = append(cmds, withVideoID(v.ID, downloadVideo(m.ctx, m.config.DownloadDir, v.URL, m.downloads[i].itag)))
+		m.updateItem(v.ID)
+	}
+	return tea.Batch(cmds...)
+}
+
+// withVideoID tags the downloadCompleteMsg of a download command with its queue entry
+func withVideoID(videoID string, cmd tea.Cmd) tea.Cmd {
+	return func() tea.Msg {
+		msg := cmd()
+		if done, ok := msg.(downloadCompleteMsg); ok {
+			done.videoID = videoID
+			return done
+		}
+		return msg
+	}
+}
+
+// handleFormatPickerKey moves through the format picker. Enter queues the
+// video with the selected format and remembers it for the rest of the session.
+func handleFormatPickerKey(m model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	picker := m.formatPicker
+	switch msg.String() {
+	case "ctrl+c", "esc", "q":
+		m.formatPicker = nil
+	case "up", "k":
+		if picker.selected > 0 {
+			picker.selected--
+		}
+	case "down", "j":
+		if picker.selected < len(picker.formats) {
+			picker.selected++
+		}
+	case "enter":
+		m.formatPicker = nil
+		m.formatItag = 0
+		if picker.selected > 0 {
+			m.formatItag = picker.formats[picker.selected-1].ItagNo
+		}
+		return m, m.enqueueDownload(picker.video)
+	}
+	return m, nil
+}
+
 func handleChannelManagerKey(m model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	key := msg.String()
 
@@ -584,6 +899,10 @@ func (m model) View() string {
 		return m.channelManagerView()
 	}
 
+	if m.formatPicker != nil {
+		return m.formatPickerView()
+	}
+
 	if m.loading {
 		spinnerView := m.spinner.View() + " Loading videos..."
 		return borderStyle.Render(spinnerView)
@@ -597,16 +916,39 @@ func (m model) View() string {
 		Bold(true).
 		Foreground(lipgloss.Color("205")).
 		Render("zebratube")
+	newCount := 0
+	for _, v := range m.videos {
+		if m.isNew(v) {
+			newCount++
+		}
+	}
+	if newCount > 0 {
+		header += newCountStyle.Render(fmt.Sprintf(" • %d new", newCount))
+	}
+
+	footerText := "r: refresh • enter: download • f: pick format • o: open • y: copy url • d: delete • /: search • c: channels • q: quit"
 
-	footerText := "r: refresh • enter: download • o: open • d: delete • /: search • c: channels • q: quit"
-	if m.downloading {
-		spinnerView := m.spinner.View()
-		footerText = fmt.Sprintf("%s Downloading...", spinnerView)
+	// Status line: queue progress and the latest message
+	var status []string
+	if running := m.countDownloads(downloadRunning); running > 0 {
+		pending := running + m.countDownloads(downloadQueued)
+		status = append(status, fmt.Sprintf("%s %d/%d downloading", m.spinner.View(), running, pending))
+	}
+	if m.statusMessage != "" {
+		status = append(status, m.statusMessage)
 	}
 
-	footer := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("240")).
-		Render(footerText)
+	statusLine := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("244")).
+		Render(strings.Join(status, " • "))
+	if m.pendingDelete != nil {
+		statusLine = confirmStyle.Render(fmt.Sprintf("Delete %s? [y/N]", m.pendingDelete.Title))
+	}
+
+	footer := statusLine + "\n" +
+		lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240")).
+			Render(footerText)
 
 	// Search bar
 	searchBar := ""
@@ -623,6 +965,50 @@ func (m model) View() string {
 	return borderStyle.Render(content)
 }
 
+func (m model) formatPickerView() string {
+	picker := m.formatPicker
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205")).
+		Render("formats")
+
+	var builder strings.Builder
+	builder.WriteString(header)
+	builder.WriteString("\n")
+	builder.WriteString(channelStyle.Render(picker.video.Title))
+	builder.WriteString("\n\n")
+
+	rows := []string{"Automatic (best guess)"}
+	for _, f := range picker.formats {
+		rows = append(rows, formatLabel(f))
+	}
+
+	// Keep the selection on screen
+	visible := len(rows)
+	if h := m.list.Height(); h > 0 && h < visible {
+		visible = h
+	}
+	first := max(picker.selected-visible+1, 0)
+	for i := first; i < len(rows) && i < first+visible; i++ {
+		if i == picker.selected {
+			builder.WriteString(selectedStyle.Render(rows[i]))
+		} else {
+			builder.WriteString(titleStyle.Render(rows[i]))
+		}
+		builder.WriteString("\n")
+	}
+
+	footer := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Render("↑/↓: select • enter: download • esc: back to videos")
+
+	builder.WriteString("\n")
+	builder.WriteString(footer)
+
+	content := strings.TrimRight(builder.String(), "\n")
+	return borderStyle.Render(content)
+}
+
 func (m model) channelManagerView() string {
 	header := lipgloss.NewStyle().
 		Bold(true).
@@ -683,10 +1069,11 @@ func loadConfig() (Config, string, error) {
 		if os.IsNotExist(err) {
 			defaultDownloadDir := filepath.Join(homeDir, "Downloads")
 			exampleConfig := Config{
-				Channels:    []string{},
-				MaxVideos:   10,
-				DownloadDir: defaultDownloadDir,
-				Colors:      defaultColors,
+				Channels:               []string{},
+				MaxVideos:              10,
+				DownloadDir:            defaultDownloadDir,
+				Colors:                 defaultColors,
+				MaxConcurrentDownloads: defaultMaxConcurrentDownloads,
 			}
 
 			dir := filepath.Dir(configPath)
@@ -723,10 +1110,47 @@ func loadConfig() (Config, string, error) {
 	if len(cfg.Colors) == 0 {
 		cfg.Colors = defaultColors
 	}
+	if cfg.MaxConcurrentDownloads <= 0 {
+		cfg.MaxConcurrentDownloads = defaultMaxConcurrentDownloads
+	}
 
 	return cfg, configPath, nil
 }
 
+// state is persisted between runs, next to the config file
+type state struct {
+	LastSeen time.Time `toml:"last_seen"` // Publish time of the newest video shown
+}
+
+func statePath(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), "cbratube", "state.toml")
+}
+
+// loadLastSeen returns the newest video time of the previous run, or the zero
+// time if there was none
+func loadLastSeen(configPath string) time.Time {
+	var st state
+	if _, err := toml.DecodeFile(statePath(configPath), &st); err != nil {
+		return time.Time{}
+	}
+	return st.LastSeen
+}
+
+func saveLastSeen(configPath string, lastSeen time.Time) error {
+	path := statePath(configPath)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return toml.NewEncoder(f).Encode(state{LastSeen: lastSeen})
+}
+
 func saveConfig(cfg Config, configPath string) error {
 	dir := filepath.Dir(configPath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -913,6 +1337,8 @@ func fetchVideos(cfg Config) ([]Video, error) {
 		return nil, fmt.Errorf("no videos found - check your channel URLs")
 	}
 
+	allVideos = dedupeVideos(allVideos)
+
 	// Sort by publish date (newest first)
 	sort.Slice(allVideos, func(i, j int) bool {
 		return allVideos[i].Published.After(allVideos[j].Published)
@@ -921,6 +1347,21 @@ func fetchVideos(cfg Config) ([]Video, error) {
 	return allVideos, nil
 }
 
+// dedupeVideos drops videos whose ID was already seen, so a video appearing
+// in several followed channels is listed once, under the first configured one
+func dedupeVideos(videos []Video) []Video {
+	seen := make(map[string]bool, len(videos))
+	unique := videos[:0]
+	for _, v := range videos {
+		if seen[v.ID] {
+			continue
+		}
+		seen[v.ID] = true
+		unique = append(unique, v)
+	}
+	return unique
+}
+
 func openURL(url string) {
 	// Simple cross-platform URL opener
 	var cmd *exec.Cmd
@@ -937,6 +1378,29 @@ func openURL(url string) {
 	go cmd.Run()
 }
 
+// copyToClipboard writes text to the system clipboard using the first
+// available clipboard tool
+func copyToClipboard(text string) error {
+	candidates := [][]string{
+		{"pbcopy"},
+		{"wl-copy"},
+		{"xclip", "-selection", "clipboard"},
+		{"xsel", "--clipboard", "--input"},
+		{"clip"},
+	}
+
+	for _, candidate := range candidates {
+		path, err := exec.LookPath(candidate[0])
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, candidate[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+	return fmt.Errorf("no clipboard tool found (install xclip, xsel, or wl-clipboard)")
+}
+
 func fileExists(path string) bool {
 	_, err := os.Stat(path)
 	return err == nil
@@ -945,8 +1409,73 @@ func fileExists(path string) bool {
 // Progress message type for the progress bar
 // Removed progress-related globals - using spinner instead
 
-// downloadVideo downloads a video using the kkdai/youtube Go library
-func downloadVideo(downloadDir, url string) tea.Cmd {
+// loadFormats fetches the downloadable formats of v, best video first and
+// audio-only formats last.
+func loadFormats(ctx context.Context, v Video) tea.Cmd {
+	return func() tea.Msg {
+		client := youtube.Client{
+			HTTPClient: &http.Client{
+				Timeout: 30 * time.Second,
+			},
+		}
+		video, err := client.GetVideoContext(ctx, v.URL)
+		if err != nil {
+			return formatsLoadedMsg{video: v, err: err}
+		}
+
+		var formats []youtube.Format
+		for _, f := range video.Formats {
+			if f.MimeType != "" {
+				formats = append(formats, f)
+			}
+		}
+		if len(formats) == 0 {
+			return formatsLoadedMsg{video: v, err: fmt.Errorf("no video formats available")}
+		}
+		sort.SliceStable(formats, func(i, j int) bool {
+			if formats[i].Height != formats[j].Height {
+				return formats[i].Height > formats[j].Height
+			}
+			return formats[i].Bitrate > formats[j].Bitrate
+		})
+		return formatsLoadedMsg{video: v, formats: formats}
+	}
+}
+
+// formatLabel describes a format for the picker: quality, streams,
+// container, codecs and size.
+func formatLabel(f youtube.Format) string {
+	quality := f.QualityLabel
+	if quality == "" {
+		quality = strings.TrimPrefix(strings.ToLower(f.AudioQuality), "audio_quality_")
+	}
+
+	streams := "video+audio"
+	if !strings.HasPrefix(f.MimeType, "video/") {
+		streams = "audio only"
+	} else if f.AudioChannels == 0 {
+		streams = "video only"
+	}
+
+	// video/mp4; codecs="avc1.64001F, mp4a.40.2"
+	mimeType, codecs, _ := strings.Cut(f.MimeType, ";")
+	_, container, _ := strings.Cut(mimeType, "/")
+	codecs = strings.TrimSpace(codecs)
+	codecs = strings.Trim(strings.TrimPrefix(codecs, "codecs="), `"`)
+
+	size := "size unknown"
+	if f.ContentLength > 0 {
+		size = fmt.Sprintf("%.1f MB", float64(f.ContentLength)/(1<<20))
+	}
+
+	return fmt.Sprintf("%-8s %-11s %-5s %-24s %s", quality, streams, container, codecs, size)
+}
+
+// downloadVideo downloads a video using the kkdai/youtube Go library.
+// Cancelling ctx aborts the download and removes the partial file.
+// itag selects the format; when it is 0 or the video doesn't offer it a
+// format is picked automatically.
+func downloadVideo(ctx context.Context, downloadDir, url string, itag int) tea.Cmd {
 	return func() tea.Msg {
 		// Create download directory if it doesn't exist
 		if downloadDir == "" {
@@ -965,7 +1494,7 @@ func downloadVideo(downloadDir, url string) tea.Cmd {
 		}
 
 		// Get video information
-		video, err := client.GetVideo(url)
+		video, err := client.GetVideoContext(ctx, url)
 		if err != nil {
 			return downloadCompleteMsg{err: fmt.Errorf("failed to get video info: %v", err)}
 		}
@@ -1018,6 +1547,17 @@ func downloadVideo(downloadDir, url string) tea.Cmd {
 			selectedFormat = &formats[0]
 		}
 
+		// A picked format goes first, the others stay as fallbacks
+		if itag != 0 {
+			for i, f := range formats {
+				if f.ItagNo == itag {
+					formats[0], formats[i] = formats[i], formats[0]
+					selectedFormat = &formats[0]
+					break
+				}
+			}
+		}
+
 		format := *selectedFormat
 
 		// Create output file path
@@ -1029,7 +1569,7 @@ func downloadVideo(downloadDir, url string) tea.Cmd {
 		ext := format.MimeType
 		if strings.Contains(ext, "video/mp4") {
 			ext = "mp4"
-		} else if strings.Contains(ext, "video/webm") {
+		} else if strings.Contains(ext, "/webm") {
 			ext = "webm"
 		} else {
 			ext = "mp4" // default
@@ -1038,7 +1578,7 @@ func downloadVideo(downloadDir, url string) tea.Cmd {
 
 		// Download video
 		// Try to get the stream - if it fails, try alternative formats or fallback to yt-dlp
-		stream, _, err := client.GetStream(video, &format)
+		stream, _, err := client.GetStreamContext(ctx, video, &format)
 		if err != nil {
 			errStr := err.Error()
 			isBaseJSError := strings.Contains(errStr, "basejs") || strings.Contains(errStr, "playerConfig")
@@ -1058,7 +1598,7 @@ func downloadVideo(downloadDir, url string) tea.Cmd {
 						continue // Skip the one we already tried
 					}
 					time.Sleep(100 * time.Millisecond) // Small delay between attempts
-					stream, _, fallbackErr = client.GetStream(video, &f)
+					stream, _, fallbackErr = client.GetStreamContext(ctx, video, &f)
 					if fallbackErr == nil {
 						format = f // Use this format instead
 						err = nil
@@ -1229,8 +1769,9 @@ func openVideo(downloadDir string, v Video) tea.Cmd {
 	}
 }
 
-// downloadVideoWithYtDlp downloads a video using yt-dlp as fallback
-func downloadVideoWithYtDlp(downloadDir, url string) tea.Cmd {
+// downloadVideoWithYtDlp downloads a video using yt-dlp as fallback.
+// Cancelling ctx kills yt-dlp.
+func downloadVideoWithYtDlp(ctx context.Context, downloadDir, url string) tea.Cmd {
 	return func() tea.Msg {
 		// Find yt-dlp
 		var cmdPath string
@@ -1255,7 +1796,7 @@ func downloadVideoWithYtDlp(downloadDir, url string) tea.Cmd {
 
 		// Build command: yt-dlp -o "path/%(title)s.%(ext)s" URL
 		outputTemplate := filepath.Join(downloadDir, "%(title)s.%(ext)s")
-		cmd := exec.Command(cmdPath,
+		cmd := exec.CommandContext(ctx, cmdPath,
 			"--no-playlist",
 			"--quiet", // Suppress output since we're not tracking progress
 			"-o", outputTemplate,
@@ -1304,10 +1845,16 @@ func main() {
 	s.Spinner = spinner.Dot
 	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	m := model{
+		ctx:                  ctx,
+		cancel:               cancel,
 		list:                 l,
 		config:               cfg,
 		configPath:           cfgPath,
+		lastSeen:             loadLastSeen(cfgPath),
 		loading:              len(cfg.Channels) > 0,
 		spinner:              s,
 		channelColors:        make(map[string]string),
@@ -0,0 +1,71 @@
+package main
+
+import "testing"
+
+func TestDedupeVideos(t *testing.T) {
+	tests := []struct {
+		name   string
+		videos []Video
+		want   []Video
+	}{
+		{
+			name:   "empty",
+			videos: nil,
+			want:   nil,
+		},
+		{
+			name: "no duplicates",
+			videos: []Video{
+				{ID: "a", Channel: "one"},
+				{ID: "b", Channel: "one"},
+			},
+			want: []Video{
+				{ID: "a", Channel: "one"},
+				{ID: "b", Channel: "one"},
+			},
+		},
+		{
+			name: "same video in two channels keeps the first",
+			videos: []Video{
+				{ID: "a", Channel: "one"},
+				{ID: "b", Channel: "one"},
+				{ID: "a", Channel: "two"},
+				{ID: "c", Channel: "two"},
+			},
+			want: []Video{
+				{ID: "a", Channel: "one"},
+				{ID: "b", Channel: "one"},
+				{ID: "c", Channel: "two"},
+			},
+		},
+		{
+			name: "order is preserved",
+			videos: []Video{
+				{ID: "c", Channel: "one"},
+				{ID: "a", Channel: "one"},
+				{ID: "c", Channel: "two"},
+				{ID: "b", Channel: "two"},
+				{ID: "a", Channel: "three"},
+			},
+			want: []Video{
+				{ID: "c", Channel: "one"},
+				{ID: "a", Channel: "one"},
+				{ID: "b", Channel: "two"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dedupeVideos(tt.videos)
+			if len(got) != len(tt.want) {
+				t.Fatalf("dedupeVideos() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("dedupeVideos()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pelletier/go-toml/v2"
 )
@@ -14,12 +15,17 @@ type Config struct {
 	ShowHidden          bool         `toml:"show_hidden"`
 	DefaultCommitMsg    string       `toml:"default_commit_message"`
 	RefreshIntervalSecs int          `toml:"refresh_interval_seconds"`
+	FetchOnScan         bool         `toml:"fetch_on_scan"` // git fetch before every scan
+	Exclude             []string     `toml:"exclude"`       // glob or substring patterns of repo paths to skip
 }
 
 type PathConfig struct {
 	Path      string `toml:"path"`
 	Name      string `toml:"name"`       // Optional custom name for display
 	ScanDepth int    `toml:"scan_depth"` // 0 = exact path only, -1 = use global max_depth
+
+	// Optional commit message for repos at or below this path
+	CommitTemplate string `toml:"commit_template,omitempty"`
 }
 
 func DefaultConfig() *Config {
@@ -35,6 +41,7 @@ func DefaultConfig() *Config {
 		ShowHidden:          false,
 		DefaultCommitMsg:    "Quick update",
 		RefreshIntervalSecs: 0, // 0 = manual refresh only
+		FetchOnScan:         false,
 	}
 }
 
@@ -88,6 +95,88 @@ func Save(cfg *Config) error {
 	return nil
 }
 
+// AddRepo adds an exact-path entry for the repo at path. The resolved
+// absolute path is stored, so a relative path still works from another
+// directory.
+func (c *Config) AddRepo(path string) error {
+	resolved := ResolvePath(path)
+	for _, p := range c.Paths {
+		if ResolvePath(p.Path) == resolved {
+			return fmt.Errorf("%s is already configured", resolved)
+		}
+	}
+
+	c.Paths = append(c.Paths, PathConfig{Path: resolved, ScanDepth: 0})
+	return nil
+}
+
+// RemoveRepo removes the entry for repoPath. Repos that were found by
+// scanning a parent directory can't be removed individually.
+func (c *Config) RemoveRepo(repoPath string) error {
+	resolved := ResolvePath(repoPath)
+
+	var paths []PathConfig
+	for _, p := range c.Paths {
+		if ResolvePath(p.Path) != resolved {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) < len(c.Paths) {
+		c.Paths = paths
+		return nil
+	}
+
+	for _, p := range c.Paths {
+		if strings.HasPrefix(resolved, ResolvePath(p.Path)+string(filepath.Separator)) {
+			return fmt.Errorf("%s is found by scanning %s; add it to exclude in the config instead", repoPath, p.Path)
+		}
+	}
+	return fmt.Errorf("%s is not in the config", repoPath)
+}
+
+// CommitMessageFor returns the commit template of the most specific path
+// entry containing repoPath, or DefaultCommitMsg if none has one
+func (c *Config) CommitMessageFor(repoPath string) string {
+	resolved := ResolvePath(repoPath)
+
+	message := c.DefaultCommitMsg
+	bestLen := -1
+	for _, p := range c.Paths {
+		if p.CommitTemplate == "" {
+			continue
+		}
+		root := ResolvePath(p.Path)
+		if root != resolved && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+			continue
+		}
+		if len(root) > bestLen {
+			message = p.CommitTemplate
+			bestLen = len(root)
+		}
+	}
+	return message
+}
+
+// ExpandPath replaces a leading ~ with the home directory
+func ExpandPath(path string) string {
+	if strings.HasPrefix(path, "~") {
+		homeDir, err := os.UserHomeDir()
+		if err == nil {
+			path = filepath.Join(homeDir, path[1:])
+		}
+	}
+	return path
+}
+
+// ResolvePath expands ~ and returns the cleaned absolute path
+func ResolvePath(path string) string {
+	path = ExpandPath(path)
+	if absPath, err := filepath.Abs(path); err == nil {
+		path = absPath
+	}
+	return filepath.Clean(path)
+}
+
 func getConfigPath() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
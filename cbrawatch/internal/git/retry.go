@@ -0,0 +1,69 @@
+package git
+
+import (
+	"strings"
+	"time"
+)
+
+// retryBackoff is how long to wait before the second attempt of a network
+// operation
+const retryBackoff = 2 * time.Second
+
+// transientHints are fragments of git output that point at a flaky network
+// rather than a problem with the repository
+var transientHints = []string{
+	"could not resolve host",
+	"connection timed out",
+	"operation timed out",
+	"connection refused",
+	"connection reset",
+	"network is unreachable",
+	"early eof",
+	"the remote end hung up unexpectedly",
+	"failed to connect",
+	"ssl_read",
+	"gnutls recv error",
+}
+
+// permanentHints win over transientHints: retrying these would fail the same
+// way, e.g. an auth failure that also reports a hung up remote
+var permanentHints = []string{
+	"authentication failed",
+	"permission denied",
+	"could not read username",
+	"repository not found",
+	"conflict",
+	"rejected",
+	"not possible to fast-forward",
+	"divergent branches",
+}
+
+// IsTransient reports whether err looks like a network hiccup worth retrying
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, hint := range permanentHints {
+		if strings.Contains(msg, hint) {
+			return false
+		}
+	}
+	for _, hint := range transientHints {
+		if strings.Contains(msg, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithRetry runs op and, if it fails with a transient error, runs it once
+// more after a short pause. retried tells whether the second attempt was made.
+func WithRetry(op func() error) (retried bool, err error) {
+	err = op()
+	if !IsTransient(err) {
+		return false, err
+	}
+	time.Sleep(retryBackoff)
+	return true, op()
+}
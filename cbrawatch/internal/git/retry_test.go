@@ -0,0 +1,43 @@
+package git
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"dns failure", errors.New("git push failed: exit status 128\nfatal: unable to access 'https://github.com/a/b/': Could not resolve host: github.com"), true},
+		{"timeout", errors.New("ssh: connect to host github.com port 22: Connection timed out"), true},
+		{"hung up", errors.New("fatal: the remote end hung up unexpectedly"), true},
+		{"auth failure and hung up", errors.New("remote: Invalid username or password.\nfatal: Authentication failed for 'https://github.com/a/b/'\nfatal: the remote end hung up unexpectedly"), false},
+		{"permission denied", errors.New("git@github.com: Permission denied (publickey).\nfatal: Could not read from remote repository."), false},
+		{"merge conflict", errors.New("CONFLICT (content): Merge conflict in main.go"), false},
+		{"rejected push", errors.New("! [rejected] main -> main (fetch first)\nerror: failed to push some refs"), false},
+		{"unrelated", errors.New("fatal: not a git repository"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransient(tt.err); got != tt.want {
+				t.Errorf("IsTransient(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithRetryPermanent(t *testing.T) {
+	calls := 0
+	retried, err := WithRetry(func() error {
+		calls++
+		return errors.New("CONFLICT (content): Merge conflict in main.go")
+	})
+	if retried || calls != 1 || err == nil {
+		t.Errorf("WithRetry() = %v, %v after %d calls, want no retry and the error", retried, err, calls)
+	}
+}
@@ -2,8 +2,12 @@ package git
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type RepoStatus struct {
@@ -15,8 +19,11 @@ type RepoStatus struct {
 	HasUpstreamChange bool
 	BranchName        string
 	Error             string
+	HasUpstream       bool // false if the branch has no upstream configured
 	AheadBy           int
 	BehindBy          int
+	LastModified      time.Time // latest of the last commit and changed files
+	RemoteURL         string    // URL of origin, empty if there is none
 }
 
 func (r *RepoStatus) IsClean() bool {
@@ -38,14 +45,26 @@ func (r *RepoStatus) StatusSummary() string {
 	if r.HasUncommitted {
 		parts = append(parts, "uncommitted")
 	}
-	if r.HasUnpushed {
+	// Unpushed and upstream changes are shown as counts by AheadBehind
+
+	return strings.Join(parts, ", ")
+}
+
+// AheadBehind returns the commit counts relative to upstream like "↑2 ↓1",
+// or an empty string if there is no upstream or the branch is in sync
+func (r *RepoStatus) AheadBehind() string {
+	if !r.HasUpstream {
+		return ""
+	}
+
+	var parts []string
+	if r.AheadBy > 0 {
 		parts = append(parts, fmt.Sprintf("↑%d", r.AheadBy))
 	}
-	if r.HasUpstreamChange {
+	if r.BehindBy > 0 {
 		parts = append(parts, fmt.Sprintf("↓%d", r.BehindBy))
 	}
-
-	return strings.Join(parts, ", ")
+	return strings.Join(parts, " ")
 }
 
 func CheckStatus(repoPath string) RepoStatus {
@@ -65,6 +84,12 @@ func CheckStatus(repoPath string) RepoStatus {
 		status.BranchName = strings.TrimSpace(string(output))
 	}
 
+	// Get origin URL (fails if there is no origin)
+	remoteCmd := exec.Command("git", "-C", repoPath, "remote", "get-url", "origin")
+	if output, err := remoteCmd.Output(); err == nil {
+		status.RemoteURL = strings.TrimSpace(string(output))
+	}
+
 	// Check for unstaged changes
 	statusCmd := exec.Command("git", "-C", repoPath, "status", "--porcelain")
 	output, err := statusCmd.Output()
@@ -73,7 +98,7 @@ func CheckStatus(repoPath string) RepoStatus {
 		return status
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	lines := strings.Split(strings.TrimRight(string(output), "\n"), "\n")
 	for _, line := range lines {
 		if len(line) < 2 {
 			continue
@@ -88,18 +113,39 @@ func CheckStatus(repoPath string) RepoStatus {
 		if line[0] == '?' && line[1] == '?' {
 			status.HasUnstaged = true
 		}
+
+		// Track when the working tree was last touched
+		if len(line) > 3 {
+			file := line[3:]
+			if i := strings.Index(file, " -> "); i >= 0 {
+				file = file[i+4:] // renamed, use the new name
+			}
+			file = strings.Trim(file, "\"")
+			if info, err := os.Stat(filepath.Join(repoPath, file)); err == nil && info.ModTime().After(status.LastModified) {
+				status.LastModified = info.ModTime()
+			}
+		}
 	}
 
-	// Check for unpushed commits and upstream changes
-	// First, try to fetch to get latest remote info (silently)
-	fetchCmd := exec.Command("git", "-C", repoPath, "fetch", "--dry-run")
-	fetchCmd.Run() // Ignore errors, repo might not have remote
+	// Last commit time (fails in a repo without commits)
+	logCmd := exec.Command("git", "-C", repoPath, "log", "-1", "--format=%ct")
+	if output, err := logCmd.Output(); err == nil {
+		if secs, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64); err == nil {
+			if committed := time.Unix(secs, 0); committed.After(status.LastModified) {
+				status.LastModified = committed
+			}
+		}
+	}
 
-	// Get ahead/behind counts
+	// Check for unpushed commits and upstream changes. These are only as
+	// fresh as the last fetch, see Fetch.
+
+	// Get ahead/behind counts (fails if there is no upstream)
 	revListCmd := exec.Command("git", "-C", repoPath, "rev-list", "--left-right", "--count", "HEAD...@{u}")
 	if output, err := revListCmd.Output(); err == nil {
 		counts := strings.Fields(strings.TrimSpace(string(output)))
 		if len(counts) == 2 {
+			status.HasUpstream = true
 			fmt.Sscanf(counts[0], "%d", &status.AheadBy)
 			fmt.Sscanf(counts[1], "%d", &status.BehindBy)
 
@@ -116,6 +162,96 @@ func isGitRepo(path string) bool {
 	return cmd.Run() == nil
 }
 
+// Fetch updates the remote-tracking refs without touching the working tree
+func Fetch(repoPath string) error {
+	if !isGitRepo(repoPath) {
+		return fmt.Errorf("not a git repository: %s", repoPath)
+	}
+
+	cmd := exec.Command("git", "-C", repoPath, "fetch", "--quiet")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git fetch failed: %v\n%s", err, string(output))
+	}
+	return nil
+}
+
+// LogEntry is a single commit of the commit log
+type LogEntry struct {
+	Hash         string
+	Subject      string
+	Author       string
+	RelativeDate string
+}
+
+// Log returns the last n commits on the current branch, newest first
+func Log(repoPath string, n int) ([]LogEntry, error) {
+	if !isGitRepo(repoPath) {
+		return nil, fmt.Errorf("not a git repository: %s", repoPath)
+	}
+
+	// Fields are separated by the unit separator, which can't appear in them
+	cmd := exec.Command("git", "-C", repoPath, "log", "-n", strconv.Itoa(n),
+		"--format=%h%x1f%s%x1f%an%x1f%ar")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		outputStr := string(output)
+		// A fresh repo without commits has no log yet
+		if strings.Contains(outputStr, "does not have any commits") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("git log failed: %v\n%s", err, outputStr)
+	}
+
+	var commits []LogEntry
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		fields := strings.Split(line, "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		commits = append(commits, LogEntry{
+			Hash:         fields[0],
+			Subject:      fields[1],
+			Author:       fields[2],
+			RelativeDate: fields[3],
+		})
+	}
+	return commits, nil
+}
+
+// Diff returns the staged and unstaged changes plus a list of untracked
+// files, i.e. everything a quick push would commit
+func Diff(repoPath string) (string, error) {
+	if !isGitRepo(repoPath) {
+		return "", fmt.Errorf("not a git repository: %s", repoPath)
+	}
+
+	sections := []struct {
+		title string
+		args  []string
+	}{
+		{"Staged changes", []string{"diff", "--cached", "--no-color"}},
+		{"Unstaged changes", []string{"diff", "--no-color"}},
+		{"Untracked files", []string{"ls-files", "--others", "--exclude-standard"}},
+	}
+
+	var b strings.Builder
+	for _, section := range sections {
+		args := append([]string{"-C", repoPath}, section.args...)
+		output, err := exec.Command("git", args...).CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("git %s failed: %v\n%s", section.args[0], err, string(output))
+		}
+		if len(strings.TrimSpace(string(output))) == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "### %s\n\n%s", section.title, output)
+	}
+	return b.String(), nil
+}
+
 func AddAll(repoPath string) error {
 	if !isGitRepo(repoPath) {
 		return fmt.Errorf("not a git repository: %s", repoPath)
@@ -178,19 +314,144 @@ func Pull(repoPath string) error {
 	return nil
 }
 
-func AddCommitPush(repoPath, message string) error {
+// Branches returns the names of all local branches
+func Branches(repoPath string) ([]string, error) {
+	if !isGitRepo(repoPath) {
+		return nil, fmt.Errorf("not a git repository: %s", repoPath)
+	}
+
+	// for-each-ref lists only refs/heads, unlike git branch which adds a
+	// "(HEAD detached at ...)" line on a detached HEAD
+	cmd := exec.Command("git", "-C", repoPath, "for-each-ref", "--format=%(refname:short)", "refs/heads/")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("git for-each-ref failed: %v\n%s", err, string(output))
+	}
+
+	var branches []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
+
+// Checkout switches to branch. It refuses to run with local changes to
+// tracked files so nothing is carried over or lost by accident.
+func Checkout(repoPath, branch string) error {
 	if !isGitRepo(repoPath) {
 		return fmt.Errorf("not a git repository: %s", repoPath)
 	}
 
+	statusCmd := exec.Command("git", "-C", repoPath, "status", "--porcelain", "--untracked-files=no")
+	output, err := statusCmd.Output()
+	if err != nil {
+		return fmt.Errorf("git status failed: %v", err)
+	}
+	if strings.TrimSpace(string(output)) != "" {
+		return fmt.Errorf("working tree has local changes; commit or stash them first")
+	}
+
+	// The trailing -- keeps branch from being read as a pathspec
+	cmd := exec.Command("git", "-C", repoPath, "checkout", branch, "--")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git checkout failed: %v\n%s", err, string(output))
+	}
+	return nil
+}
+
+// Stash saves local changes to tracked files and cleans the working tree
+func Stash(repoPath string) error {
+	if !isGitRepo(repoPath) {
+		return fmt.Errorf("not a git repository: %s", repoPath)
+	}
+
+	cmd := exec.Command("git", "-C", repoPath, "stash", "push")
+	output, err := cmd.CombinedOutput()
+	outputStr := string(output)
+	if err != nil {
+		return fmt.Errorf("git stash failed: %v\n%s", err, outputStr)
+	}
+	// git stash succeeds even if there was nothing to save
+	if strings.Contains(outputStr, "No local changes to save") {
+		return fmt.Errorf("no local changes to stash")
+	}
+	return nil
+}
+
+// StashPop restores the most recent stash
+func StashPop(repoPath string) error {
+	if !isGitRepo(repoPath) {
+		return fmt.Errorf("not a git repository: %s", repoPath)
+	}
+
+	cmd := exec.Command("git", "-C", repoPath, "stash", "pop")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		outputStr := string(output)
+		if strings.Contains(outputStr, "CONFLICT") {
+			return fmt.Errorf("stash applied with conflicts; resolve them, then run 'git stash drop'")
+		}
+		if strings.Contains(outputStr, "No stash entries found") {
+			return fmt.Errorf("no stash to pop")
+		}
+		return fmt.Errorf("git stash pop failed: %v\n%s", err, outputStr)
+	}
+	return nil
+}
+
+// SafePull stashes local changes, pulls, and pops the stash again
+func SafePull(repoPath string) error {
+	if !isGitRepo(repoPath) {
+		return fmt.Errorf("not a git repository: %s", repoPath)
+	}
+
+	// Only tracked changes get in the way of a pull
+	cmd := exec.Command("git", "-C", repoPath, "status", "--porcelain", "--untracked-files=no")
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("git status failed: %v", err)
+	}
+	stashed := strings.TrimSpace(string(output)) != ""
+
+	if stashed {
+		if err := Stash(repoPath); err != nil {
+			return fmt.Errorf("stash: %w", err)
+		}
+	}
+	if err := Pull(repoPath); err != nil {
+		if stashed {
+			// Put the changes back where they were
+			if popErr := StashPop(repoPath); popErr != nil {
+				return fmt.Errorf("pull: %w (restoring changes also failed: %v)", err, popErr)
+			}
+		}
+		return fmt.Errorf("pull: %w", err)
+	}
+	if stashed {
+		if err := StashPop(repoPath); err != nil {
+			return fmt.Errorf("pulled, but %w", err)
+		}
+	}
+	return nil
+}
+
+// AddCommitPush stages everything, commits and pushes. Only the push is
+// retried on a transient network error; retried reports whether that happened.
+func AddCommitPush(repoPath, message string) (retried bool, err error) {
+	if !isGitRepo(repoPath) {
+		return false, fmt.Errorf("not a git repository: %s", repoPath)
+	}
+
 	if err := AddAll(repoPath); err != nil {
-		return fmt.Errorf("add: %w", err)
+		return false, fmt.Errorf("add: %w", err)
 	}
 	if err := Commit(repoPath, message); err != nil {
-		return fmt.Errorf("commit: %w", err)
+		return false, fmt.Errorf("commit: %w", err)
 	}
-	if err := Push(repoPath); err != nil {
-		return fmt.Errorf("push: %w", err)
+	retried, err = WithRetry(func() error { return Push(repoPath) })
+	if err != nil {
+		return retried, fmt.Errorf("push: %w", err)
 	}
-	return nil
+	return retried, nil
 }
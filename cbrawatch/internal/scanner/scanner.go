@@ -4,18 +4,34 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"cbrawatch/internal/config"
 	"cbrawatch/internal/git"
 )
 
+// maxScanWorkers bounds how many git status checks run at once
+const maxScanWorkers = 8
+
+// ScanRepositories finds all configured repos and checks their status,
+// fetching first if fetch_on_scan is enabled
 func ScanRepositories(cfg *config.Config) []git.RepoStatus {
-	var repos []git.RepoStatus
+	return scan(cfg, cfg.FetchOnScan)
+}
+
+// FetchAndScanRepositories runs git fetch in every repo before checking
+// status, so upstream changes are up to date
+func FetchAndScanRepositories(cfg *config.Config) []git.RepoStatus {
+	return scan(cfg, true)
+}
+
+func scan(cfg *config.Config, fetch bool) []git.RepoStatus {
+	var repoPaths []string
 	seen := make(map[string]bool)
 	customNames := make(map[string]string) // Map absolute path to custom name
 
 	for _, pathCfg := range cfg.Paths {
-		expandedPath := expandPath(pathCfg.Path)
+		expandedPath := config.ExpandPath(pathCfg.Path)
 
 		// Store custom name if provided, normalize the path
 		if pathCfg.Name != "" {
@@ -33,10 +49,16 @@ func ScanRepositories(cfg *config.Config) []git.RepoStatus {
 			depth = cfg.MaxDepth
 		}
 
-		foundRepos := scanPath(expandedPath, depth, cfg.ShowHidden, seen)
-		repos = append(repos, foundRepos...)
+		repoPaths = append(repoPaths, scanPath(expandedPath, depth, cfg.ShowHidden, seen)...)
 	}
 
+	repoPaths = filterExcluded(repoPaths, cfg.Exclude)
+
+	if fetch {
+		fetchAll(repoPaths)
+	}
+	repos := checkStatuses(repoPaths)
+
 	// Apply custom names to repos (normalize repo paths for comparison)
 	for i := range repos {
 		cleanRepoPath := filepath.Clean(repos[i].Path)
@@ -48,41 +70,126 @@ func ScanRepositories(cfg *config.Config) []git.RepoStatus {
 	return repos
 }
 
-func scanPath(rootPath string, maxDepth int, showHidden bool, seen map[string]bool) []git.RepoStatus {
-	var repos []git.RepoStatus
+// filterExcluded drops repo paths matching any of the exclude patterns
+func filterExcluded(repoPaths []string, patterns []string) []string {
+	if len(patterns) == 0 {
+		return repoPaths
+	}
+
+	var kept []string
+	for _, path := range repoPaths {
+		if !isExcluded(path, patterns) {
+			kept = append(kept, path)
+		}
+	}
+	return kept
+}
+
+// isExcluded reports whether path matches a pattern. Patterns containing
+// glob characters are matched against the full path and the directory name,
+// all others are plain substring matches.
+func isExcluded(path string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		pattern = config.ExpandPath(pattern)
+
+		if strings.ContainsAny(pattern, "*?[") {
+			if ok, _ := filepath.Match(pattern, path); ok {
+				return true
+			}
+			if ok, _ := filepath.Match(pattern, filepath.Base(path)); ok {
+				return true
+			}
+			continue
+		}
+
+		if strings.Contains(path, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStatuses runs git status for all repos concurrently.
+// Results keep the order of repoPaths.
+func checkStatuses(repoPaths []string) []git.RepoStatus {
+	repos := make([]git.RepoStatus, len(repoPaths))
+	forEachConcurrently(len(repoPaths), func(i int) {
+		status := git.CheckStatus(repoPaths[i])
+		status.Path = repoPaths[i]
+		// Each call writes only its own index, so no locking is needed
+		repos[i] = status
+	})
+	return repos
+}
+
+// fetchAll runs git fetch for all repos concurrently. Failures (offline,
+// no remote) are ignored; the status then reflects the last successful fetch.
+func fetchAll(repoPaths []string) {
+	forEachConcurrently(len(repoPaths), func(i int) {
+		git.Fetch(repoPaths[i])
+	})
+}
+
+// forEachConcurrently calls fn for 0..n-1 on at most maxScanWorkers goroutines
+func forEachConcurrently(n int, fn func(i int)) {
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	workers := min(maxScanWorkers, n)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// scanPath returns the normalized paths of all git repos under rootPath
+func scanPath(rootPath string, maxDepth int, showHidden bool, seen map[string]bool) []string {
+	var repoPaths []string
 
 	// Check if root path itself is a git repo
-	if isGitRepo(rootPath) {
+	if IsGitRepo(rootPath) {
 		absPath, _ := filepath.Abs(rootPath)
 		if !seen[absPath] {
 			seen[absPath] = true
-			status := git.CheckStatus(absPath)
-			status.Path = filepath.Clean(absPath) // Normalize the path
-			repos = append(repos, status)
+			repoPaths = append(repoPaths, filepath.Clean(absPath)) // Normalize the path
 		}
-		return repos
+		return repoPaths
 	}
 
 	// If maxDepth is 0, only check the exact path
 	if maxDepth == 0 {
-		return repos
+		return repoPaths
 	}
 
 	// Scan subdirectories
-	repos = append(repos, scanRecursive(rootPath, maxDepth, 0, showHidden, seen)...)
-	return repos
+	repoPaths = append(repoPaths, scanRecursive(rootPath, maxDepth, 0, showHidden, seen)...)
+	return repoPaths
 }
 
-func scanRecursive(path string, maxDepth, currentDepth int, showHidden bool, seen map[string]bool) []git.RepoStatus {
-	var repos []git.RepoStatus
+func scanRecursive(path string, maxDepth, currentDepth int, showHidden bool, seen map[string]bool) []string {
+	var repoPaths []string
 
 	if currentDepth > maxDepth {
-		return repos
+		return repoPaths
 	}
 
 	entries, err := os.ReadDir(path)
 	if err != nil {
-		return repos
+		return repoPaths
 	}
 
 	for _, entry := range entries {
@@ -100,13 +207,11 @@ func scanRecursive(path string, maxDepth, currentDepth int, showHidden bool, see
 		fullPath := filepath.Join(path, name)
 
 		// Check if this directory is a git repo
-		if isGitRepo(fullPath) {
+		if IsGitRepo(fullPath) {
 			absPath, _ := filepath.Abs(fullPath)
 			if !seen[absPath] {
 				seen[absPath] = true
-				status := git.CheckStatus(absPath)
-				status.Path = filepath.Clean(absPath) // Normalize the path
-				repos = append(repos, status)
+				repoPaths = append(repoPaths, filepath.Clean(absPath)) // Normalize the path
 			}
 			// Don't recurse into git repos
 			continue
@@ -114,25 +219,17 @@ func scanRecursive(path string, maxDepth, currentDepth int, showHidden bool, see
 
 		// Recurse into subdirectories
 		if currentDepth < maxDepth {
-			repos = append(repos, scanRecursive(fullPath, maxDepth, currentDepth+1, showHidden, seen)...)
+			repoPaths = append(repoPaths, scanRecursive(fullPath, maxDepth, currentDepth+1, showHidden, seen)...)
 		}
 	}
 
-	return repos
+	return repoPaths
 }
 
-func isGitRepo(path string) bool {
+// IsGitRepo reports whether path is the root of a git repo, i.e. whether
+// the scanner would pick it up
+func IsGitRepo(path string) bool {
 	gitDir := filepath.Join(path, ".git")
 	info, err := os.Stat(gitDir)
 	return err == nil && info.IsDir()
 }
-
-func expandPath(path string) string {
-	if strings.HasPrefix(path, "~") {
-		homeDir, err := os.UserHomeDir()
-		if err == nil {
-			path = filepath.Join(homeDir, path[1:])
-		}
-	}
-	return path
-}
@@ -0,0 +1,102 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cbrawatch/internal/config"
+)
+
+func TestIsExcluded(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		patterns []string
+		want     bool
+	}{
+		{"no patterns", "/src/app", nil, false},
+		{"empty pattern", "/src/app", []string{""}, false},
+		{"substring", "/src/vendor/lib", []string{"/vendor/"}, true},
+		{"substring miss", "/src/vendorlib", []string{"/vendor/"}, false},
+		{"glob on full path", "/src/scratch/a", []string{"/src/scratch/*"}, true},
+		{"glob on name", "/src/tmp-build", []string{"tmp-*"}, true},
+		{"glob miss", "/src/build", []string{"tmp-*"}, false},
+		{"home glob", filepath.Join(home, "scratch", "a"), []string{"~/scratch/*"}, true},
+		{"any pattern", "/src/app", []string{"nope", "app"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isExcluded(tt.path, tt.patterns); got != tt.want {
+				t.Errorf("isExcluded(%q, %q) = %v, want %v", tt.path, tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterExcluded(t *testing.T) {
+	paths := []string{"/src/a", "/src/vendor/b", "/src/tmp-c", "/src/d"}
+	patterns := []string{"/vendor/", "tmp-*"}
+
+	got := filterExcluded(paths, patterns)
+
+	want := []string{"/src/a", "/src/d"}
+	if len(got) != len(want) {
+		t.Fatalf("filterExcluded() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("filterExcluded() = %q, want %q", got, want)
+		}
+	}
+	for _, path := range got {
+		if isExcluded(path, patterns) {
+			t.Errorf("excluded path %q was kept", path)
+		}
+	}
+}
+
+func TestFilterExcludedNoPatterns(t *testing.T) {
+	paths := []string{"/src/a", "/src/b"}
+	if got := filterExcluded(paths, nil); len(got) != len(paths) {
+		t.Errorf("filterExcluded() = %q, want %q", got, paths)
+	}
+}
+
+func TestScanRepositoriesSkipsExcluded(t *testing.T) {
+	root := t.TempDir()
+	for _, repo := range []string{"app", "vendor/lib", "tmp-build"} {
+		if err := os.MkdirAll(filepath.Join(root, repo, ".git"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := &config.Config{
+		Paths:    []config.PathConfig{{Path: root, ScanDepth: -1}},
+		MaxDepth: 2,
+		Exclude:  []string{"/vendor/", "tmp-*"},
+	}
+	repos := ScanRepositories(cfg)
+
+	found := make(map[string]bool)
+	for _, repo := range repos {
+		found[repo.Path] = true
+	}
+	if !found[filepath.Join(root, "app")] {
+		t.Errorf("ScanRepositories() = %v, missing %s", repos, filepath.Join(root, "app"))
+	}
+	for _, excluded := range []string{"vendor/lib", "tmp-build"} {
+		if path := filepath.Join(root, excluded); found[path] {
+			t.Errorf("excluded repo %s appears in the scan results", path)
+		}
+	}
+	if len(repos) != 1 {
+		t.Errorf("ScanRepositories() returned %d repos, want 1", len(repos))
+	}
+}
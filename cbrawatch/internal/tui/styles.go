@@ -48,6 +48,12 @@ var (
 				PaddingLeft(2).
 				Foreground(highlightColor)
 
+	// Header row above each directory in the grouped list
+	groupHeaderStyle = lipgloss.NewStyle().
+				PaddingLeft(2).
+				Foreground(infoColor).
+				Bold(true)
+
 	// Cursor style for selected item
 	cursorStyle = lipgloss.NewStyle().
 			Foreground(primaryColor).
@@ -100,6 +106,20 @@ var (
 			MarginLeft(2).
 			MarginRight(2)
 
+	// Diff view styles
+	diffAddStyle = lipgloss.NewStyle().
+			Foreground(cleanColor)
+
+	diffRemoveStyle = lipgloss.NewStyle().
+			Foreground(dangerColor)
+
+	diffHunkStyle = lipgloss.NewStyle().
+			Foreground(infoColor)
+
+	diffHeaderStyle = lipgloss.NewStyle().
+			Foreground(primaryColor).
+			Bold(true)
+
 	// Spinner style
 	spinnerStyle = lipgloss.NewStyle().
 			Foreground(primaryColor)